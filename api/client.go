@@ -7,7 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"time"
+	"net/url"
+	"strings"
 
 	"404skill-cli/config"
 	"404skill-cli/tracing"
@@ -21,10 +22,15 @@ type TokenProvider interface {
 // ClientInterface defines the interface for API client operations
 type ClientInterface interface {
 	ListProjects(ctx context.Context) ([]Project, error)
-	BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) error
+	BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*BulkUpdateResponse, error)
 	InitializeProject(ctx context.Context, projectId string) error
 }
 
+// AnnouncementSource is implemented by clients that can fetch the platform announcement
+type AnnouncementSource interface {
+	GetAnnouncement(ctx context.Context) (*Announcement, error)
+}
+
 // Client represents the API client
 type Client struct {
 	httpClient    *http.Client
@@ -44,6 +50,30 @@ type Project struct {
 	EstimatedDurationInMinutes int    `json:"estimated_duration_minutes"`
 	AccessTier                 string `json:"access_tier"`
 	Technologies               string `json:"technologies"`
+	PageURL                    string `json:"page_url"`
+}
+
+// WebPage returns the project's page with its instructions: the page the API reports, or else
+// the web view of its repository. It returns "" when neither is a web address.
+func (p Project) WebPage() string {
+	if isWebURL(p.PageURL) {
+		return p.PageURL
+	}
+	if isWebURL(p.RepoUrl) {
+		return strings.TrimSuffix(p.RepoUrl, ".git")
+	}
+	return ""
+}
+
+func isWebURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// Announcement is a platform message shown above the main menu, e.g. planned maintenance
+type Announcement struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
 }
 
 // ProjectTemplate represents a project template response
@@ -54,16 +84,24 @@ type ProjectTemplate struct {
 
 // NewClient creates a new API client
 func NewClient(tokenProvider TokenProvider) (*Client, error) {
+	return NewClientWithOptions(tokenProvider, DefaultClientOptions())
+}
+
+// NewClientWithOptions creates a new API client with custom transport options
+func NewClientWithOptions(tokenProvider TokenProvider, opts ClientOptions) (*Client, error) {
 	// Call GetBaseURL() and catch its error
 	baseURL, err := config.GetBaseURL()
 	if err != nil {
 		return nil, err
 	}
 
+	httpClient, err := NewHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+		httpClient:    httpClient,
 		baseURL:       baseURL,
 		tokenProvider: tokenProvider,
 	}, nil
@@ -134,7 +172,12 @@ type BulkUpdateRequest struct {
 	PassedTestNames []string `json:"passedTestNames"`
 }
 
-func (c *Client) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) error {
+// BulkUpdateResponse is the backend's reply to a bulk update; ResultsURL is empty when it has no shareable page
+type BulkUpdateResponse struct {
+	ResultsURL string `json:"resultsUrl"`
+}
+
+func (c *Client) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*BulkUpdateResponse, error) {
 	tracker := tracing.TimedOperation("http_bulk_update_profile_tests")
 	tracker.AddMetadata("project_id", projectID)
 	tracker.AddMetadata("failed_count", fmt.Sprintf("%d", len(failed)))
@@ -143,7 +186,7 @@ func (c *Client) BulkUpdateProfileTests(ctx context.Context, failed, passed []st
 	token, err := c.tokenProvider.GetToken()
 	if err != nil {
 		_ = tracker.CompleteWithError(fmt.Errorf("failed to get token: %w", err))
-		return fmt.Errorf("failed to get token: %w", err)
+		return nil, fmt.Errorf("failed to get token: %w", err)
 	}
 
 	reqBody := BulkUpdateRequest{
@@ -154,25 +197,25 @@ func (c *Client) BulkUpdateProfileTests(ctx context.Context, failed, passed []st
 	data, err := json.Marshal(reqBody)
 	if err != nil {
 		_ = tracker.CompleteWithError(fmt.Errorf("failed to marshal request: %w", err))
-		return err
+		return nil, err
 	}
 
 	url := fmt.Sprintf("%s/profile-tests/bulk-update", c.baseURL)
 	tracker.AddMetadata("url", url)
 	tracker.AddMetadata("request_size", fmt.Sprintf("%d", len(data)))
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(data))
 	if err != nil {
 		_ = tracker.CompleteWithError(fmt.Errorf("failed to create request: %w", err))
-		return err
+		return nil, err
 	}
 	req.Header.Set("Authorization", "Bearer "+token)
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		_ = tracker.CompleteWithError(fmt.Errorf("HTTP request failed: %w", err))
-		return err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
@@ -182,9 +225,55 @@ func (c *Client) BulkUpdateProfileTests(ctx context.Context, failed, passed []st
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		apiErr := fmt.Errorf("API error: %s, %s", resp.Status, string(bodyBytes))
 		_ = tracker.CompleteWithError(apiErr)
-		return apiErr
+		return nil, apiErr
 	}
 
+	// The update has been applied; a missing or unreadable body only means there is no permalink
+	var result BulkUpdateResponse
+	if bodyBytes, err := io.ReadAll(resp.Body); err == nil && len(bytes.TrimSpace(bodyBytes)) > 0 {
+		if err := json.Unmarshal(bodyBytes, &result); err != nil {
+			tracker.AddMetadata("response_decode_error", err.Error())
+		}
+	}
+	tracker.AddMetadata("has_results_url", fmt.Sprintf("%t", result.ResultsURL != ""))
+
 	_ = tracker.Complete()
-	return nil
+	return &result, nil
+}
+
+// GetAnnouncement fetches the current platform announcement, returning nil when there is none
+func (c *Client) GetAnnouncement(ctx context.Context) (*Announcement, error) {
+	token, err := c.tokenProvider.GetToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/announcement", c.baseURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var announcement Announcement
+	if err := json.NewDecoder(resp.Body).Decode(&announcement); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if announcement.ID == "" || announcement.Message == "" {
+		return nil, nil
+	}
+	return &announcement, nil
 }
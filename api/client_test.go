@@ -117,3 +117,109 @@ func TestClient_ListProjects(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_BulkUpdateProfileTests_ResultsURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantURL string
+	}{
+		{name: "returns permalink", body: `{"resultsUrl":"https://404skill.dev/r/abc123"}`, wantURL: "https://404skill.dev/r/abc123"},
+		{name: "empty body", body: "", wantURL: ""},
+		{name: "no permalink field", body: `{"updated":3}`, wantURL: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/profile-tests/bulk-update" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := &Client{
+				httpClient:    &http.Client{},
+				baseURL:       server.URL,
+				tokenProvider: &mockTokenProvider{token: "test-token"},
+			}
+
+			resp, err := client.BulkUpdateProfileTests(context.Background(), []string{"test_b"}, []string{"test_a"}, "project-1")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp == nil || resp.ResultsURL != tt.wantURL {
+				t.Errorf("got %+v, want results URL %q", resp, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestClient_GetAnnouncement(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantID  string
+		wantErr bool
+	}{
+		{name: "announcement", status: http.StatusOK, body: `{"id":"maint-42","message":"Maintenance on Sunday"}`, wantID: "maint-42"},
+		{name: "no content", status: http.StatusNoContent},
+		{name: "empty announcement", status: http.StatusOK, body: `{}`},
+		{name: "endpoint missing", status: http.StatusNotFound, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/announcement" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := &Client{
+				httpClient:    &http.Client{},
+				baseURL:       server.URL,
+				tokenProvider: &mockTokenProvider{token: "test-token"},
+			}
+
+			announcement, err := client.GetAnnouncement(context.Background())
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			gotID := ""
+			if announcement != nil {
+				gotID = announcement.ID
+			}
+			if gotID != tt.wantID {
+				t.Errorf("got announcement %+v, want ID %q", announcement, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestProject_WebPage(t *testing.T) {
+	tests := []struct {
+		name    string
+		project Project
+		want    string
+	}{
+		{"page from the API", Project{PageURL: "https://404skill.dev/p/1", RepoUrl: "https://github.com/404skill/p1.git"}, "https://404skill.dev/p/1"},
+		{"falls back to the repository", Project{RepoUrl: "https://github.com/404skill/p1.git"}, "https://github.com/404skill/p1"},
+		{"ssh repository has no page", Project{RepoUrl: "git@github.com:404skill/p1.git"}, ""},
+		{"no page", Project{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.project.WebPage(); got != tt.want {
+				t.Errorf("WebPage() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
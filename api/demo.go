@@ -0,0 +1,38 @@
+package api
+
+import "context"
+
+// demoProjects is the sample catalogue shown in demo mode
+var demoProjects = []Project{
+	{ID: "demo-kv-go", Name: "Key Value Store", Language: "go", Difficulty: "medium", Description: "Build an in-memory key-value store with TTLs", Type: "backend", EstimatedDurationInMinutes: 90, AccessTier: "free", Technologies: "Go, HTTP"},
+	{ID: "demo-kv-java", Name: "Key Value Store", Language: "java", Difficulty: "medium", Description: "Build an in-memory key-value store with TTLs", Type: "backend", EstimatedDurationInMinutes: 120, AccessTier: "free", Technologies: "Java, Spring Boot"},
+	{ID: "demo-journal-py", Name: "Journal API", Language: "python", Difficulty: "easy", Description: "A REST API for daily journal entries", Type: "backend", EstimatedDurationInMinutes: 60, AccessTier: "free", Technologies: "Python, FastAPI"},
+	{ID: "demo-journal-ts", Name: "Journal API", Language: "typescript", Difficulty: "easy", Description: "A REST API for daily journal entries", Type: "backend", EstimatedDurationInMinutes: 60, AccessTier: "free", Technologies: "TypeScript, Express"},
+	{ID: "demo-limiter-go", Name: "Rate Limiter", Language: "go", Difficulty: "hard", Description: "A distributed token-bucket rate limiter", Type: "backend", EstimatedDurationInMinutes: 180, AccessTier: "pro", Technologies: "Go, Redis"},
+}
+
+// DemoClient is an in-memory ClientInterface serving sample projects for demo mode.
+// It never touches the network and silently drops test result uploads.
+type DemoClient struct{}
+
+// NewDemoClient creates a client backed by the built-in sample projects
+func NewDemoClient() *DemoClient {
+	return &DemoClient{}
+}
+
+// ListProjects returns a copy of the sample projects
+func (d *DemoClient) ListProjects(ctx context.Context) ([]Project, error) {
+	projects := make([]Project, len(demoProjects))
+	copy(projects, demoProjects)
+	return projects, nil
+}
+
+// BulkUpdateProfileTests accepts the results without uploading them
+func (d *DemoClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*BulkUpdateResponse, error) {
+	return &BulkUpdateResponse{}, nil
+}
+
+// InitializeProject does nothing; there is no profile to register the project with
+func (d *DemoClient) InitializeProject(ctx context.Context, projectId string) error {
+	return nil
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// GetAliases returns the user-defined subcommand aliases, keyed by alias
+func (c *ConfigManager) GetAliases() map[string]string {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.Aliases
+}
+
+// ResolveAlias rewrites a leading alias in args to the subcommand it names. Built-in
+// subcommands always win, so an alias can never shadow one, and an alias may only point
+// at a built-in, not at another alias.
+func ResolveAlias(args []string, aliases map[string]string, builtins []string) ([]string, error) {
+	if len(args) == 0 || slices.Contains(builtins, args[0]) {
+		return args, nil
+	}
+	target, ok := aliases[args[0]]
+	if !ok {
+		return args, nil
+	}
+	target = strings.TrimSpace(target)
+	if !slices.Contains(builtins, target) {
+		return nil, fmt.Errorf("alias %q points to %q, which is not a subcommand (one of %s)", args[0], target, strings.Join(builtins, ", "))
+	}
+
+	resolved := make([]string, 0, len(args))
+	resolved = append(resolved, target)
+	return append(resolved, args[1:]...), nil
+}
+
+// ShadowedAliases returns the configured aliases named after a built-in subcommand, sorted.
+// They are ignored by ResolveAlias; callers report them so the user can rename them.
+func ShadowedAliases(aliases map[string]string, builtins []string) []string {
+	var shadowed []string
+	for alias := range aliases {
+		if slices.Contains(builtins, alias) {
+			shadowed = append(shadowed, alias)
+		}
+	}
+	sort.Strings(shadowed)
+	return shadowed
+}
+
+// formatAliases prints aliases as "alias=target" pairs in a stable order
+func formatAliases(aliases map[string]string) string {
+	pairs := make([]string, 0, len(aliases))
+	for alias, target := range aliases {
+		pairs = append(pairs, alias+"="+target)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
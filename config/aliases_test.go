@@ -0,0 +1,92 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testBuiltins = []string{"config", "adopt", "doctor"}
+
+func TestResolveAlias_ResolvesToTarget(t *testing.T) {
+	// Arrange
+	aliases := map[string]string{"dr": "doctor"}
+
+	// Act
+	args, err := ResolveAlias([]string{"dr", "--json"}, aliases, testBuiltins)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := []string{"doctor", "--json"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("Expected %v, got %v", want, args)
+	}
+}
+
+func TestResolveAlias_BuiltinCannotBeShadowed(t *testing.T) {
+	// Arrange
+	aliases := map[string]string{"config": "doctor"}
+
+	// Act
+	args, err := ResolveAlias([]string{"config"}, aliases, testBuiltins)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := []string{"config"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("Expected built-in to win, got %v", args)
+	}
+	if shadowed := ShadowedAliases(aliases, testBuiltins); !reflect.DeepEqual(shadowed, []string{"config"}) {
+		t.Errorf("Expected config to be reported as shadowed, got %v", shadowed)
+	}
+}
+
+func TestResolveAlias_UnknownTarget(t *testing.T) {
+	// Arrange
+	aliases := map[string]string{"x": "dr", "dr": "doctor"}
+
+	// Act
+	_, err := ResolveAlias([]string{"x"}, aliases, testBuiltins)
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), `alias "x" points to "dr"`) {
+		t.Errorf("Expected an error for an alias of an alias, got %v", err)
+	}
+}
+
+func TestResolveAlias_NoAliasPassesThrough(t *testing.T) {
+	// Act
+	args, err := ResolveAlias([]string{"something"}, nil, testBuiltins)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if want := []string{"something"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("Expected %v, got %v", want, args)
+	}
+}
+
+func TestConfigManager_GetAliases(t *testing.T) {
+	// Arrange
+	originalPath := ConfigFilePath
+	ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	defer func() {
+		ConfigFilePath = originalPath
+	}()
+	if err := os.WriteFile(ConfigFilePath, []byte("aliases:\n  dr: doctor\n"), 0600); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	// Act
+	aliases := newTestConfigManager().GetAliases()
+
+	// Assert
+	if aliases["dr"] != "doctor" {
+		t.Errorf("Expected dr -> doctor, got %v", aliases)
+	}
+}
@@ -3,34 +3,96 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
+	"404skill-cli/homedir"
+
 	"gopkg.in/yaml.v3"
 )
 
-func init() {
-	homeDir, err := os.UserHomeDir()
+// Init points ConfigFilePath at the config in the home directory, creating the directory it
+// lives in. It runs before anything reads or writes the config.
+func Init() error {
+	homeDir, err := homedir.Dir()
 	if err != nil {
-		panic("Unable to determine user home directory")
+		return fmt.Errorf("unable to determine home directory: %w", err)
 	}
 
 	err = os.MkdirAll(fmt.Sprintf("%s/.404skill", homeDir), os.ModePerm)
 	if err != nil {
-		panic("Unable to create .404skill directory")
+		return fmt.Errorf("unable to create .404skill directory: %w", err)
 	}
 
 	ConfigFilePath = fmt.Sprintf("%s/.404skill/config.yml", homeDir)
+	return nil
 }
 
 var ConfigFilePath string
 
 // Config represents the application configuration
 type Config struct {
-	Username           string          `yaml:"username"`
-	Password           string          `yaml:"password"`
-	AccessToken        string          `yaml:"access_token"`
-	LastUpdated        time.Time       `yaml:"last_updated"`
-	DownloadedProjects map[string]bool `yaml:"downloaded_projects"`
+	Username               string                 `yaml:"username"`
+	Password               string                 `yaml:"password"`
+	AccessToken            string                 `yaml:"access_token"`
+	LastUpdated            time.Time              `yaml:"last_updated"`
+	DownloadedProjects     map[string]bool        `yaml:"downloaded_projects"`
+	ProjectsDir            string                 `yaml:"projects_dir,omitempty"` // defaults to ~/404skill_projects
+	BuildKit               *bool                  `yaml:"buildkit,omitempty"`     // nil means enabled
+	FastRerun              bool                   `yaml:"fast_rerun,omitempty"`
+	DebugTests             bool                   `yaml:"debug_tests,omitempty"` // pass verbosity flags to the test command, keep all output
+	ProxyURL               string                 `yaml:"proxy_url,omitempty"`   // may contain credentials, never log it unredacted
+	APITimeouts            APITimeouts            `yaml:"api_timeouts,omitempty"`
+	CACertPath             string                 `yaml:"ca_cert_path,omitempty"`             // extra PEM roots for self-hosted backends
+	InsecureSkipVerify     bool                   `yaml:"insecure_skip_verify,omitempty"`     // development only, never the default
+	CompletionThreshold    float64                `yaml:"completion_threshold,omitempty"`     // pass rate for a task to count as complete, default 1.0
+	CompletedTasks         map[string][]int       `yaml:"completed_tasks,omitempty"`          // project ID -> completed task numbers
+	PendingInits           []string               `yaml:"pending_inits,omitempty"`            // downloaded project IDs not yet registered with the API
+	ExcludedTests          []string               `yaml:"excluded_tests,omitempty"`           // test or class glob patterns not reported to the API
+	RunHistory             map[string][]RunRecord `yaml:"run_history,omitempty"`              // project ID -> recent test runs, oldest first
+	HistoryRelativeTime    bool                   `yaml:"history_relative_time,omitempty"`    // show "2 hours ago" instead of timestamps
+	CompactResultsHeader   bool                   `yaml:"compact_results_header,omitempty"`   // one-line summary above the test results
+	PostDownloadHook       string                 `yaml:"post_download_hook,omitempty"`       // shell command run in the project directory after a download
+	Editor                 string                 `yaml:"editor,omitempty"`                   // command used to open projects, e.g. "code"; falls back to $EDITOR
+	OpenAfterDownload      *bool                  `yaml:"open_after_download,omitempty"`      // open the file explorer after a download, nil means enabled
+	AutoTestAfterDownload  bool                   `yaml:"auto_test_after_download,omitempty"` // run the tests as soon as a download finishes cleanly
+	ResourceStats          bool                   `yaml:"resource_stats,omitempty"`           // sample container memory and CPU during test runs
+	WatchReports           bool                   `yaml:"watch_reports,omitempty"`            // reload open results when a new report appears
+	CacheUnchangedRuns     bool                   `yaml:"cache_unchanged_runs,omitempty"`     // offer the last results when the sources haven't changed since
+	SourceHashIgnore       []string               `yaml:"source_hash_ignore,omitempty"`       // extra glob patterns left out of the source hash
+	TestServices           map[string]string      `yaml:"test_services,omitempty"`            // project ID -> compose service that runs the tests, default all services
+	ReportFormats          map[string]string      `yaml:"report_formats,omitempty"`           // project ID -> detected test report format
+	Announcement           *CachedAnnouncement    `yaml:"announcement,omitempty"`             // latest platform announcement, so it isn't fetched on every start
+	DismissedAnnouncements []string               `yaml:"dismissed_announcements,omitempty"`  // announcement IDs the user has closed
+	Aliases                map[string]string      `yaml:"aliases,omitempty"`                  // short name -> subcommand, e.g. "dr" -> "doctor"
+}
+
+// MaxRunHistory is how many test runs are kept per project
+const MaxRunHistory = 20
+
+// RunRecord summarizes a single test run for the history view
+type RunRecord struct {
+	Time     time.Time `yaml:"time"`
+	Passed   int       `yaml:"passed"`
+	Failed   int       `yaml:"failed"`
+	Duration float64   `yaml:"duration"` // seconds, as reported by the test suite
+}
+
+// CachedAnnouncement is the platform announcement last fetched from the API; an empty ID
+// records that there was none
+type CachedAnnouncement struct {
+	ID        string    `yaml:"id,omitempty"`
+	Message   string    `yaml:"message,omitempty"`
+	FetchedAt time.Time `yaml:"fetched_at"`
+}
+
+// APITimeouts overrides the API client's network timeouts (e.g. "15s"); zero values use the defaults
+type APITimeouts struct {
+	Dial         time.Duration `yaml:"dial,omitempty"`
+	TLSHandshake time.Duration `yaml:"tls_handshake,omitempty"`
+	Request      time.Duration `yaml:"request,omitempty"`
+	KeepAlive    time.Duration `yaml:"keep_alive,omitempty"`
 }
 
 // readConfig reads the configuration from the config file
@@ -45,6 +107,10 @@ func readConfig() (Config, error) {
 	return config, err
 }
 
+// configMu serializes the read-modify-write cycles on the config file. Commands run
+// concurrently, and without it one write could drop another's change.
+var configMu sync.Mutex
+
 // writeConfig writes the configuration to the config file
 // This is private - use ConfigManager methods instead
 func writeConfig(config Config) error {
@@ -52,7 +118,21 @@ func writeConfig(config Config) error {
 	if err != nil {
 		return err
 	}
-	return os.WriteFile(ConfigFilePath, data, 0600)
+
+	// Write a temp file and rename it over the config, so a reader never sees a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(ConfigFilePath), ".config-*.yml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), ConfigFilePath)
 }
 
 // isTokenExpired checks if a token has expired (24 hour expiry)
@@ -65,6 +145,9 @@ type SimpleConfigWriter struct{}
 
 // UpdateAuthConfig updates authentication-related configuration while preserving other settings
 func (s *SimpleConfigWriter) UpdateAuthConfig(username, password, accessToken string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
 	// Read existing config to preserve DownloadedProjects and other data
 	cfg, err := readConfig()
 	if err != nil {
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DebugTestsEnv turns on debug test runs without touching the config file
+const DebugTestsEnv = "FOURSKILL_DEBUG_TESTS"
+
+// DebugTestsOverride is set from the --debug-tests flag and wins over the environment and config file
+var DebugTestsOverride bool
+
+// debugTestsOverride returns the flag or environment setting, and whether either was given
+func debugTestsOverride() (enabled bool, ok bool) {
+	if DebugTestsOverride {
+		return true, true
+	}
+	if env := strings.TrimSpace(os.Getenv(DebugTestsEnv)); env != "" {
+		enabled, err := strconv.ParseBool(env)
+		return err == nil && enabled, true
+	}
+	return false, false
+}
@@ -0,0 +1,190 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ValueSource records where an effective configuration value came from
+type ValueSource string
+
+const (
+	SourceFlag    ValueSource = "flag"
+	SourceEnv     ValueSource = "env"
+	SourceFile    ValueSource = "file"
+	SourceDefault ValueSource = "default"
+)
+
+// redactedValue replaces secrets in printed configuration
+const redactedValue = "<redacted>"
+
+// EffectiveValue is a single resolved setting and the layer that supplied it
+type EffectiveValue struct {
+	Key    string
+	Value  string
+	Source ValueSource
+}
+
+// EffectiveConfig resolves every user-facing setting through the same precedence the CLI uses
+// (flag > env > file > default). Passwords and tokens are redacted, never returned.
+func (c *ConfigManager) EffectiveConfig() []EffectiveValue {
+	cfg, _ := readConfig() // a missing config means every value is a default
+
+	return []EffectiveValue{
+		{Key: "config_file", Value: ConfigFilePath, Source: SourceDefault},
+		fileOrDefault("username", cfg.Username, "(not set)"),
+		secret("password", cfg.Password),
+		secret("access_token", cfg.AccessToken),
+		projectsDirValue(cfg.ProjectsDir),
+		{Key: "buildkit", Value: strconv.FormatBool(c.IsBuildKitEnabled()), Source: sourceIf(cfg.BuildKit != nil)},
+		{Key: "open_after_download", Value: strconv.FormatBool(c.IsOpenAfterDownloadEnabled()), Source: sourceIf(cfg.OpenAfterDownload != nil)},
+		{Key: "auto_test_after_download", Value: strconv.FormatBool(cfg.AutoTestAfterDownload), Source: sourceIf(cfg.AutoTestAfterDownload)},
+		{Key: "resource_stats", Value: strconv.FormatBool(cfg.ResourceStats), Source: sourceIf(cfg.ResourceStats)},
+		{Key: "watch_reports", Value: strconv.FormatBool(cfg.WatchReports), Source: sourceIf(cfg.WatchReports)},
+		{Key: "cache_unchanged_runs", Value: strconv.FormatBool(cfg.CacheUnchangedRuns), Source: sourceIf(cfg.CacheUnchangedRuns)},
+		fileOrDefault("source_hash_ignore", strings.Join(cfg.SourceHashIgnore, ", "), "(build output only)"),
+		{Key: "fast_rerun", Value: strconv.FormatBool(cfg.FastRerun), Source: sourceIf(cfg.FastRerun)},
+		debugTestsValue(cfg.DebugTests),
+		proxyValue(cfg.ProxyURL),
+		durationValue("api_timeouts.dial", cfg.APITimeouts.Dial),
+		durationValue("api_timeouts.tls_handshake", cfg.APITimeouts.TLSHandshake),
+		durationValue("api_timeouts.request", cfg.APITimeouts.Request),
+		durationValue("api_timeouts.keep_alive", cfg.APITimeouts.KeepAlive),
+		fileOrDefault("ca_cert_path", cfg.CACertPath, "(system roots)"),
+		fileOrDefault("excluded_tests", strings.Join(cfg.ExcludedTests, ", "), "(none)"),
+		fileOrDefault("post_download_hook", cfg.PostDownloadHook, "(none)"),
+		fileOrDefault("editor", cfg.Editor, "$EDITOR, code or idea"),
+		fileOrDefault("aliases", formatAliases(cfg.Aliases), "(none)"),
+		{Key: "insecure_skip_verify", Value: strconv.FormatBool(cfg.InsecureSkipVerify), Source: sourceIf(cfg.InsecureSkipVerify)},
+		{
+			Key:    "completion_threshold",
+			Value:  strconv.FormatFloat(c.GetCompletionThreshold(), 'g', -1, 64),
+			Source: sourceIf(cfg.CompletionThreshold > 0 && cfg.CompletionThreshold <= 1),
+		},
+	}
+}
+
+// WriteEffectiveConfig prints resolved values as an aligned key/value/source table
+func WriteEffectiveConfig(w io.Writer, values []EffectiveValue) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "KEY\tVALUE\tSOURCE")
+	for _, v := range values {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", v.Key, v.Value, v.Source)
+	}
+	return tw.Flush()
+}
+
+func sourceIf(fromFile bool) ValueSource {
+	if fromFile {
+		return SourceFile
+	}
+	return SourceDefault
+}
+
+func fileOrDefault(key, value, fallback string) EffectiveValue {
+	if value == "" {
+		return EffectiveValue{Key: key, Value: fallback, Source: SourceDefault}
+	}
+	return EffectiveValue{Key: key, Value: value, Source: SourceFile}
+}
+
+func secret(key, value string) EffectiveValue {
+	if value == "" {
+		return EffectiveValue{Key: key, Value: "(not set)", Source: SourceDefault}
+	}
+	return EffectiveValue{Key: key, Value: redactedValue, Source: SourceFile}
+}
+
+func durationValue(key string, d time.Duration) EffectiveValue {
+	if d == 0 {
+		return EffectiveValue{Key: key, Value: "(built-in)", Source: SourceDefault}
+	}
+	return EffectiveValue{Key: key, Value: d.String(), Source: SourceFile}
+}
+
+// Secrets returns the configured credentials so diagnostics can scrub them from logs.
+// The values must never be printed.
+func (c *ConfigManager) Secrets() []string {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+
+	var secrets []string
+	for _, s := range []string{cfg.Password, cfg.AccessToken} {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+	if u, err := url.Parse(cfg.ProxyURL); err == nil && u.User != nil {
+		if password, ok := u.User.Password(); ok && password != "" {
+			secrets = append(secrets, password)
+		}
+	}
+	return secrets
+}
+
+// projectsDirValue mirrors ResolveProjectsDir, reporting which layer won
+func projectsDirValue(configured string) EffectiveValue {
+	value := EffectiveValue{Key: "projects_dir"}
+	switch {
+	case strings.TrimSpace(ProjectsDirOverride) != "":
+		value.Source = SourceFlag
+	case strings.TrimSpace(os.Getenv(ProjectsDirEnv)) != "":
+		value.Source = SourceEnv
+	case strings.TrimSpace(configured) != "":
+		value.Source = SourceFile
+	default:
+		value.Source = SourceDefault
+	}
+
+	dir, err := ResolveProjectsDir(configured)
+	if err != nil {
+		dir = fmt.Sprintf("(error: %v)", err)
+	}
+	value.Value = dir
+	return value
+}
+
+// debugTestsValue reports whether debug test runs are on and which layer turned them on
+func debugTestsValue(configured bool) EffectiveValue {
+	value := EffectiveValue{Key: "debug_tests", Value: strconv.FormatBool(configured), Source: sourceIf(configured)}
+	if enabled, ok := debugTestsOverride(); ok {
+		value.Value = strconv.FormatBool(enabled)
+		value.Source = SourceEnv
+		if DebugTestsOverride {
+			value.Source = SourceFlag
+		}
+	}
+	return value
+}
+
+// proxyValue reports the explicit proxy, falling back to the standard proxy environment variables
+func proxyValue(configured string) EffectiveValue {
+	if configured != "" {
+		return EffectiveValue{Key: "proxy_url", Value: redactURL(configured), Source: SourceFile}
+	}
+	for _, name := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+		if env := os.Getenv(name); env != "" {
+			return EffectiveValue{Key: "proxy_url", Value: redactURL(env), Source: SourceEnv}
+		}
+	}
+	return EffectiveValue{Key: "proxy_url", Value: "(none)", Source: SourceDefault}
+}
+
+// redactURL masks any password in a URL; unparseable values that look credentialed are hidden entirely
+func redactURL(raw string) string {
+	if u, err := url.Parse(raw); err == nil && u.Host != "" {
+		return u.Redacted()
+	}
+	if strings.Contains(raw, "@") {
+		return redactedValue
+	}
+	return raw
+}
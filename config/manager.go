@@ -3,6 +3,9 @@ package config
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"404skill-cli/auth"
@@ -57,6 +60,9 @@ func (c *ConfigManager) IsProjectDownloaded(projectID string) bool {
 
 // UpdateDownloadedProject marks a project as downloaded
 func (c *ConfigManager) UpdateDownloadedProject(projectID string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
 	cfg, err := readConfig()
 	if err != nil {
 		return err
@@ -68,8 +74,474 @@ func (c *ConfigManager) UpdateDownloadedProject(projectID string) error {
 	return writeConfig(cfg)
 }
 
+// GetProjectsDir returns where projects are downloaded, honoring --projects-dir and FOURSKILL_PROJECTS_DIR
+func (c *ConfigManager) GetProjectsDir() (string, error) {
+	cfg, _ := readConfig() // a missing config just means nothing is configured
+	return ResolveProjectsDir(cfg.ProjectsDir)
+}
+
+// IsBuildKitEnabled reports whether test images should be built with BuildKit (enabled unless turned off)
+func (c *ConfigManager) IsBuildKitEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil || cfg.BuildKit == nil {
+		return true
+	}
+	return *cfg.BuildKit
+}
+
+// IsOpenAfterDownloadEnabled reports whether the file explorer opens on a finished download (enabled unless turned off)
+func (c *ConfigManager) IsOpenAfterDownloadEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil || cfg.OpenAfterDownload == nil {
+		return true
+	}
+	return *cfg.OpenAfterDownload
+}
+
+// IsAutoTestAfterDownloadEnabled reports whether a test run starts as soon as a download finishes (disabled unless turned on)
+func (c *ConfigManager) IsAutoTestAfterDownloadEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.AutoTestAfterDownload
+}
+
+// IsResourceStatsEnabled reports whether test runs sample their containers' memory and CPU (disabled unless turned on)
+func (c *ConfigManager) IsResourceStatsEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.ResourceStats
+}
+
+// IsWatchReportsEnabled reports whether open results reload when tests write a new report (disabled unless turned on)
+func (c *ConfigManager) IsWatchReportsEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.WatchReports
+}
+
+// IsCacheUnchangedRunsEnabled reports whether testing an unchanged project offers its last results instead (disabled unless turned on)
+func (c *ConfigManager) IsCacheUnchangedRunsEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.CacheUnchangedRuns
+}
+
+// GetSourceHashIgnore returns the extra glob patterns left out when hashing a project's sources
+func (c *ConfigManager) GetSourceHashIgnore() []string {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.SourceHashIgnore
+}
+
+// IsFastRerunEnabled reports whether test runs should reuse the existing image by default
+func (c *ConfigManager) IsFastRerunEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.FastRerun
+}
+
+// IsDebugTestsEnabled reports whether tests run with maximum diagnostics, honoring --debug-tests and FOURSKILL_DEBUG_TESTS
+func (c *ConfigManager) IsDebugTestsEnabled() bool {
+	if enabled, ok := debugTestsOverride(); ok {
+		return enabled
+	}
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.DebugTests
+}
+
+// GetProxyURL returns the explicit API proxy URL, or an empty string to use the environment
+func (c *ConfigManager) GetProxyURL() string {
+	cfg, err := readConfig()
+	if err != nil {
+		return ""
+	}
+	return cfg.ProxyURL
+}
+
+// GetAPITimeouts returns the configured API timeout overrides
+func (c *ConfigManager) GetAPITimeouts() APITimeouts {
+	cfg, err := readConfig()
+	if err != nil {
+		return APITimeouts{}
+	}
+	return cfg.APITimeouts
+}
+
+// GetCACertPath returns the path of a custom CA bundle, or an empty string for the system roots
+func (c *ConfigManager) GetCACertPath() string {
+	cfg, err := readConfig()
+	if err != nil {
+		return ""
+	}
+	return cfg.CACertPath
+}
+
+// IsInsecureSkipVerifyEnabled reports whether TLS certificate verification has been explicitly disabled
+func (c *ConfigManager) IsInsecureSkipVerifyEnabled() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.InsecureSkipVerify
+}
+
+// GetCompletionThreshold returns the pass rate at which a task counts as complete.
+// Unset or out-of-range values fall back to requiring every test to pass.
+func (c *ConfigManager) GetCompletionThreshold() float64 {
+	cfg, err := readConfig()
+	if err != nil || cfg.CompletionThreshold <= 0 || cfg.CompletionThreshold > 1 {
+		return 1.0
+	}
+	return cfg.CompletionThreshold
+}
+
+// GetExcludedTestPatterns returns glob patterns for scaffold tests that should not count toward progress
+func (c *ConfigManager) GetExcludedTestPatterns() []string {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.ExcludedTests
+}
+
+// GetPostDownloadHook returns the command to run after a project downloads, or "" when none is set.
+// The hook is only ever read from the user's own config file, which is what opting in means here.
+func (c *ConfigManager) GetPostDownloadHook() string {
+	cfg, err := readConfig()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(cfg.PostDownloadHook)
+}
+
+// GetEditor returns the configured editor command, or "" to fall back to $EDITOR and editor detection
+func (c *ConfigManager) GetEditor() string {
+	cfg, err := readConfig()
+	if err != nil {
+		return ""
+	}
+	return cfg.Editor
+}
+
+// GetCompletedTasks returns the task numbers recorded as complete for a project, in ascending order
+func (c *ConfigManager) GetCompletedTasks(projectID string) []int {
+	cfg, err := readConfig()
+	if err != nil || cfg.CompletedTasks == nil {
+		return nil
+	}
+	return cfg.CompletedTasks[projectID]
+}
+
+// RecordCompletedTasks persists completed tasks for a project and returns the ones that were not complete before
+func (c *ConfigManager) RecordCompletedTasks(projectID string, tasks []int) ([]int, error) {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	if cfg.CompletedTasks == nil {
+		cfg.CompletedTasks = make(map[string][]int)
+	}
+
+	known := make(map[int]bool)
+	for _, task := range cfg.CompletedTasks[projectID] {
+		known[task] = true
+	}
+
+	var newlyCompleted []int
+	for _, task := range tasks {
+		if !known[task] {
+			known[task] = true
+			newlyCompleted = append(newlyCompleted, task)
+		}
+	}
+	if len(newlyCompleted) == 0 {
+		return nil, nil
+	}
+
+	all := make([]int, 0, len(known))
+	for task := range known {
+		all = append(all, task)
+	}
+	sort.Ints(all)
+	cfg.CompletedTasks[projectID] = all
+
+	if err := writeConfig(cfg); err != nil {
+		return nil, fmt.Errorf("failed to save completed tasks: %w", err)
+	}
+	return newlyCompleted, nil
+}
+
+// QueueProjectInitialization records a downloaded project whose API initialization must be retried
+func (c *ConfigManager) QueueProjectInitialization(projectID string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	for _, pending := range cfg.PendingInits {
+		if pending == projectID {
+			return nil
+		}
+	}
+	cfg.PendingInits = append(cfg.PendingInits, projectID)
+	if err := writeConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save pending initialization: %w", err)
+	}
+	return nil
+}
+
+// GetPendingInitializations returns the project IDs still waiting to be initialized in the API
+func (c *ConfigManager) GetPendingInitializations() []string {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.PendingInits
+}
+
+// RemovePendingInitialization drops a project from the retry queue once it has been initialized
+func (c *ConfigManager) RemovePendingInitialization(projectID string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	remaining := cfg.PendingInits[:0]
+	for _, pending := range cfg.PendingInits {
+		if pending != projectID {
+			remaining = append(remaining, pending)
+		}
+	}
+	if len(remaining) == len(cfg.PendingInits) {
+		return nil
+	}
+	cfg.PendingInits = remaining
+	if err := writeConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save pending initializations: %w", err)
+	}
+	return nil
+}
+
+// RecordRun appends a test run to the project's history, keeping only the most recent MaxRunHistory runs
+func (c *ConfigManager) RecordRun(projectID string, run RunRecord) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	if cfg.RunHistory == nil {
+		cfg.RunHistory = make(map[string][]RunRecord)
+	}
+
+	runs := append(cfg.RunHistory[projectID], run)
+	if len(runs) > MaxRunHistory {
+		runs = runs[len(runs)-MaxRunHistory:]
+	}
+	cfg.RunHistory[projectID] = runs
+
+	if err := writeConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save run history: %w", err)
+	}
+	return nil
+}
+
+// GetRunHistory returns the recorded test runs for a project, oldest first
+func (c *ConfigManager) GetRunHistory(projectID string) []RunRecord {
+	cfg, err := readConfig()
+	if err != nil || cfg.RunHistory == nil {
+		return nil
+	}
+	return cfg.RunHistory[projectID]
+}
+
+// GetAllRunHistory returns the recorded test runs of every project, keyed by project ID
+func (c *ConfigManager) GetAllRunHistory() map[string][]RunRecord {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.RunHistory
+}
+
+// GetCachedAnnouncement returns the announcement last fetched from the API, or nil if none was fetched yet
+func (c *ConfigManager) GetCachedAnnouncement() *CachedAnnouncement {
+	cfg, err := readConfig()
+	if err != nil {
+		return nil
+	}
+	return cfg.Announcement
+}
+
+// SetCachedAnnouncement remembers the latest announcement and when it was fetched
+func (c *ConfigManager) SetCachedAnnouncement(announcement CachedAnnouncement) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	cfg.Announcement = &announcement
+	if err := writeConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save announcement: %w", err)
+	}
+	return nil
+}
+
+// DismissAnnouncement hides the announcement with this ID for good
+func (c *ConfigManager) DismissAnnouncement(id string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	for _, dismissed := range cfg.DismissedAnnouncements {
+		if dismissed == id {
+			return nil
+		}
+	}
+	cfg.DismissedAnnouncements = append(cfg.DismissedAnnouncements, id)
+	if err := writeConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save dismissed announcement: %w", err)
+	}
+	return nil
+}
+
+// IsAnnouncementDismissed reports whether the user has closed the announcement with this ID
+func (c *ConfigManager) IsAnnouncementDismissed(id string) bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	for _, dismissed := range cfg.DismissedAnnouncements {
+		if dismissed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// IsHistoryRelativeTime reports whether the history view shows relative times ("2 hours ago")
+func (c *ConfigManager) IsHistoryRelativeTime() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.HistoryRelativeTime
+}
+
+// SetHistoryRelativeTime persists the history view's time format preference
+func (c *ConfigManager) SetHistoryRelativeTime(relative bool) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	cfg.HistoryRelativeTime = relative
+	return writeConfig(cfg)
+}
+
+// IsCompactResultsHeader reports whether the test results header is collapsed to a single line
+func (c *ConfigManager) IsCompactResultsHeader() bool {
+	cfg, err := readConfig()
+	if err != nil {
+		return false
+	}
+	return cfg.CompactResultsHeader
+}
+
+// SetCompactResultsHeader persists whether the test results header is collapsed
+func (c *ConfigManager) SetCompactResultsHeader(compact bool) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	cfg.CompactResultsHeader = compact
+	return writeConfig(cfg)
+}
+
+// GetReportFormat returns the test report format detected for a project, or "" if none was recorded
+func (c *ConfigManager) GetReportFormat(projectID string) string {
+	cfg, err := readConfig()
+	if err != nil || cfg.ReportFormats == nil {
+		return ""
+	}
+	return cfg.ReportFormats[projectID]
+}
+
+// GetTestService returns the compose service configured to run a project's tests, or "" to run every service
+func (c *ConfigManager) GetTestService(projectID string) string {
+	cfg, err := readConfig()
+	if err != nil {
+		return ""
+	}
+	return cfg.TestServices[projectID]
+}
+
+// SetReportFormat records the test report format detected for a project
+func (c *ConfigManager) SetReportFormat(projectID, format string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
+	cfg, err := readConfig()
+	if err != nil {
+		return fmt.Errorf("failed to read config: %w", err)
+	}
+	if cfg.ReportFormats[projectID] == format {
+		return nil
+	}
+	if cfg.ReportFormats == nil {
+		cfg.ReportFormats = make(map[string]string)
+	}
+	cfg.ReportFormats[projectID] = format
+	if err := writeConfig(cfg); err != nil {
+		return fmt.Errorf("failed to save report format: %w", err)
+	}
+	return nil
+}
+
+// GetResultsDir returns where the latest test results of each project are saved, next to the config file
+func (c *ConfigManager) GetResultsDir() string {
+	return filepath.Join(filepath.Dir(ConfigFilePath), "results")
+}
+
 // UpdateAuthConfig updates authentication-related configuration while preserving other settings
 func (c *ConfigManager) UpdateAuthConfig(username, password, accessToken string) error {
+	configMu.Lock()
+	defer configMu.Unlock()
+
 	// Read existing config to preserve DownloadedProjects and other data
 	cfg, err := readConfig()
 	if err != nil {
@@ -2,7 +2,10 @@ package config
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
@@ -652,3 +655,200 @@ func TestConfigManager_GetToken_ExpiredToken(t *testing.T) {
 		t.Error("Expected error when trying to refresh expired token")
 	}
 }
+
+// TestConfigManager_IsBuildKitEnabled tests the BuildKit toggle and its default
+func TestConfigManager_IsBuildKitEnabled(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = "/tmp/test_buildkit.yml"
+	defer func() {
+		ConfigFilePath = originalPath
+		os.Remove("/tmp/test_buildkit.yml")
+	}()
+
+	// Act & Assert - defaults to enabled when unset
+	if err := writeConfig(Config{Username: "testuser"}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	if !manager.IsBuildKitEnabled() {
+		t.Error("Expected BuildKit to be enabled by default")
+	}
+
+	disabled := false
+	if err := writeConfig(Config{Username: "testuser", BuildKit: &disabled}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	if manager.IsBuildKitEnabled() {
+		t.Error("Expected BuildKit to be disabled when turned off in config")
+	}
+}
+
+// TestConfigManager_RecordCompletedTasks tests that completion persists and only new tasks are reported
+func TestConfigManager_RecordCompletedTasks(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = "/tmp/test_completed_tasks.yml"
+	defer func() {
+		ConfigFilePath = originalPath
+		os.Remove("/tmp/test_completed_tasks.yml")
+	}()
+	if err := writeConfig(Config{Username: "testuser"}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// Act
+	first, err := manager.RecordCompletedTasks("project-1", []int{2, 1})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	second, err := manager.RecordCompletedTasks("project-1", []int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Assert
+	if len(first) != 2 {
+		t.Errorf("Expected both tasks to be new on first run, got %v", first)
+	}
+	if len(second) != 1 || second[0] != 3 {
+		t.Errorf("Expected only Task 3 to be new, got %v", second)
+	}
+	if got := manager.GetCompletedTasks("project-1"); len(got) != 3 || got[0] != 1 || got[2] != 3 {
+		t.Errorf("Expected sorted completed tasks [1 2 3], got %v", got)
+	}
+	if got := manager.GetCompletedTasks("project-2"); len(got) != 0 {
+		t.Errorf("Expected no completed tasks for another project, got %v", got)
+	}
+}
+
+// TestConfigManager_GetCompletionThreshold tests the default and out-of-range fallback
+func TestConfigManager_GetCompletionThreshold(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = "/tmp/test_completion_threshold.yml"
+	defer func() {
+		ConfigFilePath = originalPath
+		os.Remove("/tmp/test_completion_threshold.yml")
+	}()
+
+	tests := []struct {
+		configured float64
+		expected   float64
+	}{
+		{0, 1.0},
+		{0.8, 0.8},
+		{1.5, 1.0},
+	}
+
+	for _, tt := range tests {
+		// Act
+		if err := writeConfig(Config{CompletionThreshold: tt.configured}); err != nil {
+			t.Fatalf("Failed to write test config: %v", err)
+		}
+
+		// Assert
+		if got := manager.GetCompletionThreshold(); got != tt.expected {
+			t.Errorf("Configured %v: expected %v, got %v", tt.configured, tt.expected, got)
+		}
+	}
+}
+
+// TestConfigManager_RecordRun_KeepsMostRecent tests that run history is capped per project
+func TestConfigManager_RecordRun_KeepsMostRecent(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = "/tmp/test_run_history.yml"
+	defer func() {
+		ConfigFilePath = originalPath
+		os.Remove("/tmp/test_run_history.yml")
+	}()
+	if err := writeConfig(Config{}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// Act
+	for i := 0; i < MaxRunHistory+5; i++ {
+		if err := manager.RecordRun("project-1", RunRecord{Passed: i}); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	// Assert
+	runs := manager.GetRunHistory("project-1")
+	if len(runs) != MaxRunHistory {
+		t.Fatalf("Expected %d runs, got %d", MaxRunHistory, len(runs))
+	}
+	if runs[0].Passed != 5 || runs[len(runs)-1].Passed != MaxRunHistory+4 {
+		t.Errorf("Expected the oldest runs to be dropped, got first=%d last=%d", runs[0].Passed, runs[len(runs)-1].Passed)
+	}
+}
+
+func TestConfigManager_DismissAnnouncement_PersistsByID(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	defer func() { ConfigFilePath = originalPath }()
+	if err := writeConfig(Config{}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// Act
+	for i := 0; i < 2; i++ {
+		if err := manager.DismissAnnouncement("maint-42"); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	// Assert - a fresh manager reads the dismissal back from disk
+	reloaded := newTestConfigManager()
+	if !reloaded.IsAnnouncementDismissed("maint-42") {
+		t.Error("Expected maint-42 to stay dismissed")
+	}
+	if reloaded.IsAnnouncementDismissed("launch-7") {
+		t.Error("Expected other announcements to still be shown")
+	}
+	cfg, _ := readConfig()
+	if len(cfg.DismissedAnnouncements) != 1 {
+		t.Errorf("Expected the ID to be stored once, got %v", cfg.DismissedAnnouncements)
+	}
+}
+
+func TestConfigManager_ConcurrentWritesKeepEveryChange(t *testing.T) {
+	// Arrange - the announcement is cached while other commands update the config
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	defer func() { ConfigFilePath = originalPath }()
+	if err := writeConfig(Config{}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			_ = manager.SetCachedAnnouncement(CachedAnnouncement{ID: fmt.Sprintf("a-%d", i)})
+		}(i)
+		go func(i int) {
+			defer wg.Done()
+			_ = manager.UpdateDownloadedProject(fmt.Sprintf("p%d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	// Assert
+	downloaded := manager.GetDownloadedProjects()
+	if len(downloaded) != 50 {
+		t.Errorf("Expected all 50 downloads to be recorded, got %d", len(downloaded))
+	}
+	if manager.GetCachedAnnouncement() == nil {
+		t.Error("Expected the announcement to be cached")
+	}
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"404skill-cli/homedir"
+)
+
+// DefaultProjectsDirName is the directory in the user's home where projects are downloaded
+const DefaultProjectsDirName = "404skill_projects"
+
+// ProjectsDirEnv overrides the projects directory from the config file
+const ProjectsDirEnv = "FOURSKILL_PROJECTS_DIR"
+
+// ProjectsDirOverride is set from the --projects-dir flag and wins over the environment and config file
+var ProjectsDirOverride string
+
+// ResolveProjectsDir picks the projects directory by precedence: flag override, environment,
+// config file value, then ~/404skill_projects. A leading ~ is expanded in every source.
+func ResolveProjectsDir(configured string) (string, error) {
+	for _, candidate := range []string{ProjectsDirOverride, os.Getenv(ProjectsDirEnv), configured} {
+		if strings.TrimSpace(candidate) != "" {
+			return ExpandHome(strings.TrimSpace(candidate))
+		}
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, DefaultProjectsDirName), nil
+}
+
+// ExpandHome expands a leading ~ to the user's home directory
+func ExpandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, `~\`) {
+		return path, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, path[1:]), nil
+}
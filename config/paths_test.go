@@ -0,0 +1,203 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"404skill-cli/homedir"
+)
+
+// setProjectsDirOverride sets the --projects-dir override for the duration of a test
+func setProjectsDirOverride(t *testing.T, dir string) {
+	t.Helper()
+	original := ProjectsDirOverride
+	ProjectsDirOverride = dir
+	t.Cleanup(func() { ProjectsDirOverride = original })
+}
+
+func TestResolveProjectsDir_Precedence(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Fatalf("Failed to get home directory: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		flag       string
+		env        string
+		configured string
+		expected   string
+	}{
+		{"default", "", "", "", filepath.Join(home, DefaultProjectsDirName)},
+		{"config file", "", "", "/srv/config-projects", "/srv/config-projects"},
+		{"environment over config", "", "/srv/env-projects", "/srv/config-projects", "/srv/env-projects"},
+		{"flag over environment and config", "/tmp/scratch", "/srv/env-projects", "/srv/config-projects", "/tmp/scratch"},
+		{"tilde expanded", "~/scratch", "", "", filepath.Join(home, "scratch")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			setProjectsDirOverride(t, tt.flag)
+			t.Setenv(ProjectsDirEnv, tt.env)
+
+			// Act
+			dir, err := ResolveProjectsDir(tt.configured)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if dir != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, dir)
+			}
+		})
+	}
+}
+
+func TestConfigManager_GetProjectsDir_FlagWinsOverConfig(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	defer func() { ConfigFilePath = originalPath }()
+	t.Setenv(ProjectsDirEnv, "")
+	if err := writeConfig(Config{ProjectsDir: "/srv/config-projects"}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// Act
+	fromConfig, _ := manager.GetProjectsDir()
+	setProjectsDirOverride(t, "/tmp/scratch")
+	fromFlag, err := manager.GetProjectsDir()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if fromConfig != "/srv/config-projects" {
+		t.Errorf("Expected config value without the flag, got %q", fromConfig)
+	}
+	if fromFlag != "/tmp/scratch" {
+		t.Errorf("Expected flag value to win over config, got %q", fromFlag)
+	}
+}
+
+func TestExpandHome(t *testing.T) {
+	home, _ := os.UserHomeDir()
+
+	tests := map[string]string{
+		"~":              home,
+		"~/projects":     filepath.Join(home, "projects"),
+		"/abs/path":      "/abs/path",
+		"relative/path":  "relative/path",
+		"~other/project": "~other/project",
+	}
+
+	for input, expected := range tests {
+		if got, err := ExpandHome(input); err != nil || got != expected {
+			t.Errorf("ExpandHome(%q) = %q, %v; expected %q", input, got, err, expected)
+		}
+	}
+}
+
+func TestConfigManager_IsDebugTestsEnabled_Precedence(t *testing.T) {
+	// Arrange
+	manager := newTestConfigManager()
+	originalPath := ConfigFilePath
+	ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	originalOverride := DebugTestsOverride
+	defer func() {
+		ConfigFilePath = originalPath
+		DebugTestsOverride = originalOverride
+	}()
+	if err := writeConfig(Config{DebugTests: true}); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// Act & Assert
+	t.Setenv(DebugTestsEnv, "")
+	if !manager.IsDebugTestsEnabled() {
+		t.Error("Expected the config file to enable debug tests")
+	}
+	t.Setenv(DebugTestsEnv, "false")
+	if manager.IsDebugTestsEnabled() {
+		t.Error("Expected the environment to override the config file")
+	}
+	DebugTestsOverride = true
+	if !manager.IsDebugTestsEnabled() {
+		t.Error("Expected the flag to override the environment")
+	}
+}
+
+func TestExpandHome_FourskillHomeOverride(t *testing.T) {
+	// Arrange
+	home := t.TempDir()
+	t.Setenv(homedir.Env, home)
+	t.Setenv("HOME", "")
+
+	// Act
+	expanded, err := ExpandHome("~/projects")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error with HOME unset, got: %v", err)
+	}
+	if expanded != filepath.Join(home, "projects") {
+		t.Errorf("Expected ~ to expand to %s, got %s", home, expanded)
+	}
+}
+
+func TestResolveProjectsDir_InvalidFourskillHome(t *testing.T) {
+	// Arrange
+	setProjectsDirOverride(t, "")
+	t.Setenv(ProjectsDirEnv, "")
+	t.Setenv(homedir.Env, "not/absolute")
+
+	// Act
+	_, err := ResolveProjectsDir("")
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), homedir.Env) {
+		t.Errorf("Expected a clear error naming %s, got: %v", homedir.Env, err)
+	}
+}
+
+func TestInit_UsesFourskillHome(t *testing.T) {
+	// Arrange
+	original := ConfigFilePath
+	t.Cleanup(func() { ConfigFilePath = original })
+	home := t.TempDir()
+	t.Setenv(homedir.Env, home)
+
+	// Act
+	err := Init()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if ConfigFilePath != filepath.Join(home, ".404skill", "config.yml") {
+		t.Errorf("Expected the config under %s, got %s", home, ConfigFilePath)
+	}
+	if info, err := os.Stat(filepath.Join(home, ".404skill")); err != nil || !info.IsDir() {
+		t.Errorf("Expected the .404skill directory to be created, stat returned: %v", err)
+	}
+}
+
+func TestInit_InvalidFourskillHome(t *testing.T) {
+	// Arrange
+	original := ConfigFilePath
+	t.Cleanup(func() { ConfigFilePath = original })
+	t.Setenv(homedir.Env, "not/absolute")
+
+	// Act
+	err := Init()
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), homedir.Env) {
+		t.Errorf("Expected a clear error naming %s, got: %v", homedir.Env, err)
+	}
+}
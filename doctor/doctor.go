@@ -0,0 +1,181 @@
+// Package doctor checks that the machine is ready to download and test projects.
+package doctor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"404skill-cli/config"
+)
+
+// Status is the outcome of a single check
+type Status string
+
+const (
+	StatusOK   Status = "ok"
+	StatusWarn Status = "warn" // works, but something the user probably wants to fix
+	StatusFail Status = "fail"
+)
+
+// Check is one environment check and how to fix it when it doesn't pass
+type Check struct {
+	Name        string
+	Remediation string
+	Run         func() (Status, string) // returns the status and what was found
+}
+
+// Result is the outcome of a check as reported to the user
+type Result struct {
+	Name        string `json:"name"`
+	Status      Status `json:"status"`
+	Detail      string `json:"detail"`
+	Remediation string `json:"remediation,omitempty"` // only set when the check did not pass
+}
+
+// Report is the outcome of every check
+type Report struct {
+	Healthy bool     `json:"healthy"`
+	Checks  []Result `json:"checks"`
+}
+
+// EnvironmentChecker is the part of the test runner the checks need
+type EnvironmentChecker interface {
+	CheckDocker() error
+	ComposeCommand() ([]string, error)
+}
+
+// DefaultChecks returns the checks run by `404skill doctor`
+func DefaultChecks(configManager *config.ConfigManager, env EnvironmentChecker) []Check {
+	return []Check{
+		{
+			Name:        "Config file",
+			Remediation: "Run 404skill once to create it, or check the permissions of " + config.ConfigFilePath,
+			Run: func() (Status, string) {
+				if _, err := os.Stat(config.ConfigFilePath); err != nil {
+					return StatusWarn, config.ConfigFilePath + " does not exist yet"
+				}
+				return StatusOK, config.ConfigFilePath
+			},
+		},
+		{
+			Name:        "Logged in",
+			Remediation: "Run 404skill and log in",
+			Run: func() (Status, string) {
+				if !configManager.HasCredentials() {
+					return StatusWarn, "no saved credentials"
+				}
+				return StatusOK, "credentials saved"
+			},
+		},
+		{
+			Name:        "Projects directory",
+			Remediation: "Set projects_dir in the config or " + config.ProjectsDirEnv + " to a writable directory",
+			Run: func() (Status, string) {
+				dir, err := configManager.GetProjectsDir()
+				if err != nil {
+					return StatusFail, err.Error()
+				}
+				if err := checkWritable(dir); err != nil {
+					return StatusFail, err.Error()
+				}
+				return StatusOK, dir
+			},
+		},
+		{
+			Name:        "Docker",
+			Remediation: "Install Docker Desktop (or Docker Engine) and start it",
+			Run: func() (Status, string) {
+				if err := env.CheckDocker(); err != nil {
+					return StatusFail, err.Error()
+				}
+				return StatusOK, "docker is installed and running"
+			},
+		},
+		{
+			Name:        "Docker Compose",
+			Remediation: "Install the docker compose plugin or the standalone docker-compose",
+			Run: func() (Status, string) {
+				compose, err := env.ComposeCommand()
+				if err != nil {
+					return StatusFail, err.Error()
+				}
+				return StatusOK, strings.Join(compose, " ")
+			},
+		},
+	}
+}
+
+// Run runs every check; the report is healthy unless a check failed
+func Run(checks []Check) Report {
+	report := Report{Healthy: true, Checks: make([]Result, 0, len(checks))}
+	for _, check := range checks {
+		status, detail := check.Run()
+		result := Result{Name: check.Name, Status: status, Detail: detail}
+		if status != StatusOK {
+			result.Remediation = check.Remediation
+		}
+		if status == StatusFail {
+			report.Healthy = false
+		}
+		report.Checks = append(report.Checks, result)
+	}
+	return report
+}
+
+// WriteText prints the report as a checklist, with how to fix each problem
+func WriteText(w io.Writer, report Report) error {
+	for _, result := range report.Checks {
+		if _, err := fmt.Fprintf(w, "%s %s: %s\n", statusMark(result.Status), result.Name, result.Detail); err != nil {
+			return err
+		}
+		if result.Remediation != "" {
+			if _, err := fmt.Fprintf(w, "    → %s\n", result.Remediation); err != nil {
+				return err
+			}
+		}
+	}
+	summary := "Everything looks good."
+	if !report.Healthy {
+		summary = "Some checks failed; fix them before downloading or testing projects."
+	}
+	_, err := fmt.Fprintln(w, "\n"+summary)
+	return err
+}
+
+// WriteJSON prints the report for scripts
+func WriteJSON(w io.Writer, report Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to encode doctor report: %w", err)
+	}
+	return nil
+}
+
+func statusMark(status Status) string {
+	switch status {
+	case StatusOK:
+		return "✓"
+	case StatusWarn:
+		return "!"
+	default:
+		return "✗"
+	}
+}
+
+// checkWritable verifies files can be created in dir, creating it if needed
+func checkWritable(dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("%s cannot be created: %w", dir, err)
+	}
+	probe, err := os.CreateTemp(dir, ".write-check-*")
+	if err != nil {
+		return fmt.Errorf("%s is not writable: %w", dir, err)
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+	return nil
+}
@@ -0,0 +1,101 @@
+package doctor
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"404skill-cli/config"
+)
+
+// fakeEnvironment reports a fixed Docker and Compose setup
+type fakeEnvironment struct {
+	dockerErr  error
+	compose    []string
+	composeErr error
+}
+
+func (f fakeEnvironment) CheckDocker() error                { return f.dockerErr }
+func (f fakeEnvironment) ComposeCommand() ([]string, error) { return f.compose, f.composeErr }
+
+// useTempConfig points the config package at a config file with the given content
+func useTempConfig(t *testing.T, content string) {
+	t.Helper()
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	if err := os.WriteFile(config.ConfigFilePath, []byte(content), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+}
+
+func TestWriteJSON_EntryPerCheck(t *testing.T) {
+	// Arrange
+	useTempConfig(t, "projects_dir: "+t.TempDir()+"\n")
+	t.Setenv(config.ProjectsDirEnv, "")
+	env := fakeEnvironment{dockerErr: errors.New("Docker Desktop is not running"), compose: []string{"docker", "compose"}}
+	checks := DefaultChecks(config.NewConfigManager(nil), env)
+
+	// Act
+	var out bytes.Buffer
+	if err := WriteJSON(&out, Run(checks)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Assert
+	var report Report
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		t.Fatalf("Expected valid JSON, got %v:\n%s", err, out.String())
+	}
+	if report.Healthy {
+		t.Error("Expected an unhealthy report when Docker is down")
+	}
+	if len(report.Checks) != len(checks) {
+		t.Fatalf("Expected %d checks, got %d", len(checks), len(report.Checks))
+	}
+	expected := map[string]Status{
+		"Config file":        StatusOK,
+		"Logged in":          StatusWarn,
+		"Projects directory": StatusOK,
+		"Docker":             StatusFail,
+		"Docker Compose":     StatusOK,
+	}
+	for _, result := range report.Checks {
+		if result.Status != expected[result.Name] {
+			t.Errorf("Expected %s to be %q, got %q (%s)", result.Name, expected[result.Name], result.Status, result.Detail)
+		}
+		if (result.Status == StatusOK) != (result.Remediation == "") {
+			t.Errorf("Expected remediation only for checks that didn't pass, got %+v", result)
+		}
+	}
+	if !strings.Contains(out.String(), `"remediation": "Install Docker Desktop`) {
+		t.Errorf("Expected the Docker remediation in the output, got:\n%s", out.String())
+	}
+}
+
+func TestRun_WarningsStayHealthy(t *testing.T) {
+	// Arrange
+	checks := []Check{
+		{Name: "fine", Run: func() (Status, string) { return StatusOK, "" }},
+		{Name: "meh", Remediation: "fix it", Run: func() (Status, string) { return StatusWarn, "not ideal" }},
+	}
+
+	// Act
+	report := Run(checks)
+
+	// Assert
+	if !report.Healthy {
+		t.Error("Expected warnings alone to leave the report healthy")
+	}
+	var out bytes.Buffer
+	if err := WriteText(&out, report); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(out.String(), "! meh: not ideal") || !strings.Contains(out.String(), "→ fix it") {
+		t.Errorf("Unexpected checklist:\n%s", out.String())
+	}
+}
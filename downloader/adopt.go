@@ -0,0 +1,105 @@
+package downloader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+)
+
+// FindAdoptable lists project directories that were cloned by hand: they are named like a
+// download and are git checkouts, but the config has no record of them and no download of
+// them was interrupted
+func FindAdoptable(projectsDir string, downloaded map[string]bool) ([]IncompleteDownload, error) {
+	found, err := FindIncompleteDownloads(projectsDir, downloaded)
+	if err != nil {
+		return nil, err
+	}
+
+	var adoptable []IncompleteDownload
+	for _, download := range found {
+		if !download.HasMarker && isDir(filepath.Join(projectsDir, download.DirName)) {
+			adoptable = append(adoptable, download)
+		}
+	}
+	return adoptable, nil
+}
+
+// AdoptCandidate is an unrecorded checkout named exactly like the download of a known project
+type AdoptCandidate struct {
+	DirName string
+	Project api.Project
+}
+
+// MatchAdoptable pairs adoptable directories with the project they were cloned from. Only a
+// directory named exactly "<repo>_<id>" for one of projects matches; anything else may be
+// the user's own checkout and is left out.
+func MatchAdoptable(adoptable []IncompleteDownload, projects []api.Project) []AdoptCandidate {
+	var candidates []AdoptCandidate
+	for _, download := range adoptable {
+		if project, ok := projectForDir(download.DirName, projects); ok {
+			candidates = append(candidates, AdoptCandidate{DirName: download.DirName, Project: project})
+		}
+	}
+	return candidates
+}
+
+// projectForDir returns the project whose download directory is dirName
+func projectForDir(dirName string, projects []api.Project) (api.Project, bool) {
+	for i := range projects {
+		if ProjectDirName(&projects[i]) == dirName {
+			return projects[i], true
+		}
+	}
+	return api.Project{}, false
+}
+
+// harnessComposeFile is the compose file the project's test harness in .tests/<dir> runs
+const harnessComposeFile = "docker-compose.test.yml"
+
+// AdoptProject records a project cloned outside the CLI as downloaded, so it can be tested.
+// dir is a directory name in the projects directory, or a path to one; it must be named
+// exactly like the download of one of projects, and its test harness must be cloned into
+// .tests/<dir>. It returns the adopted project.
+func AdoptProject(configManager *config.ConfigManager, projectsDir, dir string, projects []api.Project) (api.Project, error) {
+	dirName := filepath.Base(filepath.Clean(dir))
+	if filepath.IsAbs(dir) || strings.ContainsAny(dir, `/\`) {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return api.Project{}, fmt.Errorf("failed to resolve %s: %w", dir, err)
+		}
+		absProjects, err := filepath.Abs(projectsDir)
+		if err != nil {
+			return api.Project{}, fmt.Errorf("failed to resolve projects directory: %w", err)
+		}
+		if filepath.Dir(absDir) != absProjects {
+			return api.Project{}, fmt.Errorf("%s is not in the projects directory %s", dir, projectsDir)
+		}
+	}
+
+	if dirName == "." || dirName == ".." || strings.HasPrefix(dirName, ".") {
+		return api.Project{}, fmt.Errorf("invalid project directory name %q", dirName)
+	}
+	project, ok := projectForDir(dirName, projects)
+	if !ok {
+		return api.Project{}, fmt.Errorf("%q is not named like any 404skill project: rename it to <project_name>_<project_id>", dirName)
+	}
+	if !isDir(filepath.Join(projectsDir, dirName)) {
+		return api.Project{}, fmt.Errorf("project directory %s not found in %s", dirName, projectsDir)
+	}
+	if _, err := os.Stat(markerPath(projectsDir, dirName)); err == nil {
+		return api.Project{}, fmt.Errorf("the download of %s was interrupted: download it again or clean it up instead", dirName)
+	}
+	harness := filepath.Join(projectsDir, ".tests", dirName, harnessComposeFile)
+	if info, err := os.Stat(harness); err != nil || info.IsDir() {
+		return api.Project{}, fmt.Errorf("the tests of %s are missing (%s not found): clone its test repository into .tests/%s or download the project instead", dirName, harness, dirName)
+	}
+
+	if err := configManager.UpdateDownloadedProject(project.ID); err != nil {
+		return api.Project{}, fmt.Errorf("failed to record %s as downloaded: %w", dirName, err)
+	}
+	return project, nil
+}
@@ -0,0 +1,125 @@
+package downloader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+)
+
+// knownProjects is the project list the API would return in the adopt tests
+var knownProjects = []api.Project{{ID: "123", Name: "Journal API"}, {ID: "1", Name: "Interrupted"}, {ID: "9", Name: "Missing"}, {ID: "5", Name: "Elsewhere"}, {ID: "7", Name: "No Tests"}}
+
+func TestProjectIDFromDirName(t *testing.T) {
+	tests := []struct {
+		dirName string
+		want    string
+	}{
+		{"journal_api_123", "123"},
+		{"todo-app_abc-42", "abc-42"},
+		{"single", ""},
+		{"trailing_", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dirName, func(t *testing.T) {
+			// Act
+			got := ProjectIDFromDirName(tt.dirName)
+
+			// Assert
+			if got != tt.want {
+				t.Errorf("Expected ID %q for %q, got %q", tt.want, tt.dirName, got)
+			}
+		})
+	}
+}
+
+// writeHarness creates the test harness of the project downloaded into dirName
+func writeHarness(t *testing.T, projectsDir, dirName string) {
+	t.Helper()
+	testsDir := filepath.Join(projectsDir, ".tests", dirName)
+	mustMkdir(t, testsDir)
+	if err := os.WriteFile(filepath.Join(testsDir, harnessComposeFile), []byte("services: {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to write the test harness: %v", err)
+	}
+}
+
+func TestAdoptProject_RecordsDownloadedFlag(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	configManager := config.NewConfigManager(nil)
+	projectsDir := t.TempDir()
+	mustMkdir(t, filepath.Join(projectsDir, "journal_api_123", ".git"))
+	writeHarness(t, projectsDir, "journal_api_123")
+
+	// Act
+	project, err := AdoptProject(configManager, projectsDir, filepath.Join(projectsDir, "journal_api_123"), knownProjects)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if project.ID != "123" {
+		t.Errorf("Expected project ID 123, got %q", project.ID)
+	}
+	if !configManager.GetDownloadedProjects()["123"] {
+		t.Error("Expected project 123 to be recorded as downloaded")
+	}
+	adoptable, err := FindAdoptable(projectsDir, configManager.GetDownloadedProjects())
+	if err != nil {
+		t.Fatalf("Expected no error listing adoptable projects, got: %v", err)
+	}
+	if len(adoptable) != 0 {
+		t.Errorf("Expected nothing left to adopt, got %+v", adoptable)
+	}
+}
+
+func TestAdoptProject_Rejects(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	configManager := config.NewConfigManager(nil)
+	projectsDir := t.TempDir()
+	mustMkdir(t, filepath.Join(projectsDir, "interrupted_1", ".git"))
+	if err := writeInProgressMarker(projectsDir, "interrupted_1"); err != nil {
+		t.Fatalf("Failed to write marker: %v", err)
+	}
+	mustMkdir(t, filepath.Join(projectsDir, "noid"))
+	mustMkdir(t, filepath.Join(projectsDir, "dotfiles_backup", ".git"))
+	mustMkdir(t, filepath.Join(projectsDir, "my_fork_123", ".git"))
+	mustMkdir(t, filepath.Join(projectsDir, "no_tests_7", ".git"))
+
+	for _, dir := range []string{"interrupted_1", "noid", "dotfiles_backup", "my_fork_123", "missing_9", "no_tests_7", filepath.Join(t.TempDir(), "elsewhere_5")} {
+		// Act
+		_, err := AdoptProject(configManager, projectsDir, dir, knownProjects)
+
+		// Assert
+		if err == nil {
+			t.Errorf("Expected adopting %s to fail", dir)
+		}
+	}
+	if downloaded := configManager.GetDownloadedProjects(); len(downloaded) != 0 {
+		t.Errorf("Expected nothing recorded, got %v", downloaded)
+	}
+}
+
+func TestMatchAdoptable_OnlyExactProjectDirectories(t *testing.T) {
+	// Arrange
+	adoptable := []IncompleteDownload{
+		{DirName: "journal_api_123", ProjectID: "123"},
+		{DirName: "dotfiles_backup", ProjectID: "backup"},
+		{DirName: "my_fork_123", ProjectID: "123"},
+	}
+
+	// Act
+	candidates := MatchAdoptable(adoptable, knownProjects)
+
+	// Assert
+	if len(candidates) != 1 {
+		t.Fatalf("Expected only journal_api_123 to match, got %+v", candidates)
+	}
+	if candidates[0].DirName != "journal_api_123" || candidates[0].Project.Name != "Journal API" {
+		t.Errorf("Expected journal_api_123 to match Journal API, got %+v", candidates[0])
+	}
+}
@@ -0,0 +1,38 @@
+package downloader
+
+import (
+	"context"
+	"time"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+)
+
+// demoStepDelay paces the simulated download so the progress bar is visible
+const demoStepDelay = 80 * time.Millisecond
+
+// DemoDownloader pretends to download projects for demo mode: it reports progress and
+// records the project as downloaded, but never clones anything
+type DemoDownloader struct {
+	configManager *config.ConfigManager
+}
+
+// NewDemoDownloader creates a downloader that only records downloads in the config
+func NewDemoDownloader(configManager *config.ConfigManager) *DemoDownloader {
+	return &DemoDownloader{configManager: configManager}
+}
+
+// DownloadProject simulates a download and marks the project as downloaded
+func (d *DemoDownloader) DownloadProject(ctx context.Context, project *api.Project, language string, progressCallback ProgressCallback) error {
+	for step := 1; step <= 10; step++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(demoStepDelay):
+		}
+		if progressCallback != nil {
+			progressCallback(float64(step) / 10)
+		}
+	}
+	return d.configManager.UpdateDownloadedProject(project.ID)
+}
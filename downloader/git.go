@@ -6,8 +6,8 @@ import (
 	"404skill-cli/filesystem"
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
 	"strconv"
@@ -19,6 +19,7 @@ type GitDownloader struct {
 	fileManager   *filesystem.Manager
 	configManager *config.ConfigManager
 	apiClient     api.ClientInterface
+	openExplorer  func(path string) error // opens the finished download, replaced in tests
 }
 
 // NewGitDownloader creates a new Git-based downloader
@@ -27,18 +28,29 @@ func NewGitDownloader(fileManager *filesystem.Manager, configManager *config.Con
 		fileManager:   fileManager,
 		configManager: configManager,
 		apiClient:     apiClient,
+		openExplorer:  fileManager.OpenFileExplorer,
 	}
 }
 
 // DownloadProject downloads a project using git clone
 func (g *GitDownloader) DownloadProject(ctx context.Context, project *api.Project, language string, progressCallback ProgressCallback) error {
+	return g.DownloadProjectWithOutput(ctx, project, language, progressCallback, nil)
+}
+
+// ProjectDirName returns the directory a project is cloned into, "<repo>_<id>"
+func ProjectDirName(project *api.Project) string {
+	repoName := strings.ToLower(strings.ReplaceAll(project.Name, " ", "_"))
+	return fmt.Sprintf("%s_%s", repoName, project.ID)
+}
+
+// DownloadProjectWithOutput downloads a project and streams post-download hook output to outputCallback
+func (g *GitDownloader) DownloadProjectWithOutput(ctx context.Context, project *api.Project, language string, progressCallback ProgressCallback, outputCallback OutputCallback) error {
 	// Create projects directory if it doesn't exist
-	homeDir, err := os.UserHomeDir()
+	projectsDir, err := g.configManager.GetProjectsDir()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return fmt.Errorf("failed to resolve projects directory: %w", err)
 	}
 
-	projectsDir := filepath.Join(homeDir, "404skill_projects")
 	if err := g.fileManager.CreateDirectory(projectsDir); err != nil {
 		return fmt.Errorf("failed to create projects directory: %w", err)
 	}
@@ -46,7 +58,13 @@ func (g *GitDownloader) DownloadProject(ctx context.Context, project *api.Projec
 	// Format project name for repo URL
 	repoName := strings.ToLower(strings.ReplaceAll(project.Name, " ", "_"))
 	repoURL := fmt.Sprintf("https://github.com/404skill/%s_%s", repoName, project.ID)
-	targetDir := filepath.Join(projectsDir, fmt.Sprintf("%s_%s", repoName, project.ID))
+	dirName := ProjectDirName(project)
+	targetDir := filepath.Join(projectsDir, dirName)
+
+	// The marker outlives a crash, so the next launch can offer to clean up the partial clone
+	if err := writeInProgressMarker(projectsDir, dirName); err != nil {
+		return err
+	}
 
 	// Create progress callback for main project (0-50%)
 	mainProgressCallback := func(progress float64) {
@@ -57,7 +75,7 @@ func (g *GitDownloader) DownloadProject(ctx context.Context, project *api.Projec
 
 	// Clone main project repository
 	if err := g.cloneMainProject(ctx, repoURL, targetDir, mainProgressCallback); err != nil {
-		return err
+		return abandonDownload(projectsDir, dirName, err)
 	}
 
 	// Create progress callback for test project (50-100%)
@@ -68,31 +86,109 @@ func (g *GitDownloader) DownloadProject(ctx context.Context, project *api.Projec
 	}
 
 	if err := g.cloneTestProject(ctx, repoName, project.ID, projectsDir, testProgressCallback); err != nil {
-		return err
+		return abandonDownload(projectsDir, dirName, err)
 	}
 
 	// Verify the clone was successful
 	if !g.fileManager.DirectoryExists(targetDir) {
-		return fmt.Errorf("clone appeared to succeed but target directory is missing")
+		return abandonDownload(projectsDir, dirName, fmt.Errorf("clone appeared to succeed but target directory is missing"))
 	}
 
-	// Update config with downloaded project
-	if err := g.configManager.UpdateDownloadedProject(project.ID); err != nil {
-		return fmt.Errorf("failed to update config: %w", err)
+	warnings := g.completeDownload(ctx, project.ID, projectsDir, dirName, outputCallback)
+	if warnings != nil && !IsWarning(warnings) {
+		return warnings
 	}
 
-	// Initialize project in API
-	if err := g.apiClient.InitializeProject(ctx, project.ID); err != nil {
-		return fmt.Errorf("failed to initialize project: %w", err)
+	g.openDownloadedProject(ctx, targetDir)
+
+	return warnings
+}
+
+// abandonDownload removes what a failed or cancelled download cloned, along with its in-progress
+// marker, and returns err. Nothing in the directory predates the download: the clone starts by
+// removing it.
+func abandonDownload(projectsDir, dirName string, err error) error {
+	if cleanErr := CleanIncompleteDownload(projectsDir, IncompleteDownload{DirName: dirName, HasMarker: true}); cleanErr != nil {
+		return errors.Join(err, cleanErr)
 	}
+	return err
+}
 
-	// Open file explorer at the cloned directory
-	if err := g.fileManager.OpenFileExplorer(targetDir); err != nil {
+// openDownloadedProject opens the file explorer at the cloned directory, unless turned off in the
+// config or for this download only with WithOpenAfterDownload
+func (g *GitDownloader) openDownloadedProject(ctx context.Context, targetDir string) {
+	open, ok := openAfterDownloadOverride(ctx)
+	if !ok {
+		open = g.configManager.IsOpenAfterDownloadEnabled()
+	}
+	if !open {
+		return
+	}
+	if err := g.openExplorer(targetDir); err != nil {
 		// Don't return error here, as the download was successful
 		fmt.Printf("Warning: Failed to open file explorer: %v\n", err)
 	}
+}
 
-	return nil
+// completeDownload clears a cloned project's in-progress marker, records it and runs the
+// post-download hook. Failures after the project is recorded are returned as warnings.
+func (g *GitDownloader) completeDownload(ctx context.Context, projectID, projectsDir, dirName string, outputCallback OutputCallback) error {
+	// The clone is whole, so even if recording it fails it is no longer a partial download
+	if err := clearInProgressMarker(projectsDir, dirName); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+	initErr := g.recordDownload(ctx, projectID)
+	var pending *InitPendingError
+	if initErr != nil && !errors.As(initErr, &pending) {
+		return initErr
+	}
+
+	// Only the hook from the user's own config runs; nothing shipped in the project repo is executed
+	var hookErr error
+	if hook := g.configManager.GetPostDownloadHook(); hook != "" {
+		if outputCallback != nil {
+			outputCallback("Running post-download hook: " + hook)
+		}
+		hookErr = runPostDownloadHook(ctx, hook, filepath.Join(projectsDir, dirName), outputCallback)
+	}
+
+	return errors.Join(initErr, hookErr)
+}
+
+// recordDownload marks the project as downloaded and registers it with the API.
+// The code is already on disk at this point, so a failed registration is queued for
+// retry and reported as an *InitPendingError instead of failing the download.
+func (g *GitDownloader) recordDownload(ctx context.Context, projectID string) error {
+	if err := g.configManager.UpdateDownloadedProject(projectID); err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+
+	initErr := g.apiClient.InitializeProject(ctx, projectID)
+	if initErr == nil {
+		return nil
+	}
+
+	if err := g.configManager.QueueProjectInitialization(projectID); err != nil {
+		return fmt.Errorf("failed to initialize project: %w", initErr)
+	}
+	return &InitPendingError{ProjectID: projectID, Err: initErr}
+}
+
+// RetryPendingInitializations registers previously downloaded projects whose initialization failed
+func (g *GitDownloader) RetryPendingInitializations(ctx context.Context) (int, error) {
+	var succeeded int
+	var lastErr error
+	for _, projectID := range g.configManager.GetPendingInitializations() {
+		if err := g.apiClient.InitializeProject(ctx, projectID); err != nil {
+			lastErr = fmt.Errorf("failed to initialize project %s: %w", projectID, err)
+			continue
+		}
+		if err := g.configManager.RemovePendingInitialization(projectID); err != nil {
+			return succeeded, err
+		}
+		succeeded++
+	}
+	return succeeded, lastErr
 }
 
 // cloneMainProject clones the main project repository
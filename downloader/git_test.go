@@ -0,0 +1,237 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+	"404skill-cli/filesystem"
+)
+
+// MockClient implements api.ClientInterface for testing
+type MockClient struct {
+	initProjectFunc func(ctx context.Context, projectID string) error
+}
+
+func (m *MockClient) ListProjects(ctx context.Context) ([]api.Project, error) {
+	return nil, nil
+}
+
+func (m *MockClient) InitializeProject(ctx context.Context, projectID string) error {
+	if m.initProjectFunc != nil {
+		return m.initProjectFunc(ctx, projectID)
+	}
+	return nil
+}
+
+func (m *MockClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*api.BulkUpdateResponse, error) {
+	return &api.BulkUpdateResponse{}, nil
+}
+
+// useTempConfig points the config package at an empty config file for the duration of a test
+func useTempConfig(t *testing.T) {
+	t.Helper()
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	if err := os.WriteFile(config.ConfigFilePath, []byte("{}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+}
+
+func TestRecordDownload_InitFailureQueuesRetry(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	configManager := config.NewConfigManager(nil)
+	client := &MockClient{initProjectFunc: func(ctx context.Context, projectID string) error {
+		return errors.New("unexpected status code: 503")
+	}}
+	downloader := NewGitDownloader(filesystem.NewManager(), configManager, client)
+
+	// Act
+	err := downloader.recordDownload(context.Background(), "project-1")
+
+	// Assert
+	var pending *InitPendingError
+	if !errors.As(err, &pending) {
+		t.Fatalf("Expected an InitPendingError, got: %v", err)
+	}
+	if pending.ProjectID != "project-1" {
+		t.Errorf("Expected pending project-1, got %q", pending.ProjectID)
+	}
+	if !configManager.IsProjectDownloaded("project-1") {
+		t.Error("Expected the project to be recorded as downloaded despite the init failure")
+	}
+	queued := configManager.GetPendingInitializations()
+	if len(queued) != 1 || queued[0] != "project-1" {
+		t.Errorf("Expected the initialization to be queued, got %v", queued)
+	}
+}
+
+func TestRecordDownload_InitSuccessQueuesNothing(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	configManager := config.NewConfigManager(nil)
+	downloader := NewGitDownloader(filesystem.NewManager(), configManager, &MockClient{})
+
+	// Act
+	err := downloader.recordDownload(context.Background(), "project-1")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if queued := configManager.GetPendingInitializations(); len(queued) != 0 {
+		t.Errorf("Expected nothing queued, got %v", queued)
+	}
+}
+
+func TestRetryPendingInitializations(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	configManager := config.NewConfigManager(nil)
+	for _, id := range []string{"ok-project", "still-failing"} {
+		if err := configManager.QueueProjectInitialization(id); err != nil {
+			t.Fatalf("Failed to queue initialization: %v", err)
+		}
+	}
+	client := &MockClient{initProjectFunc: func(ctx context.Context, projectID string) error {
+		if projectID == "still-failing" {
+			return errors.New("unexpected status code: 500")
+		}
+		return nil
+	}}
+	downloader := NewGitDownloader(filesystem.NewManager(), configManager, client)
+
+	// Act
+	succeeded, err := downloader.RetryPendingInitializations(context.Background())
+
+	// Assert
+	if succeeded != 1 {
+		t.Errorf("Expected 1 successful retry, got %d", succeeded)
+	}
+	if err == nil {
+		t.Error("Expected the remaining failure to be reported")
+	}
+	queued := configManager.GetPendingInitializations()
+	if len(queued) != 1 || queued[0] != "still-failing" {
+		t.Errorf("Expected only the failing project to stay queued, got %v", queued)
+	}
+}
+
+func TestCompleteDownload_RunsHookInProjectDirectory(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	projectsDir := t.TempDir()
+	projectDir := filepath.Join(projectsDir, "journal_api_1")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatalf("Failed to create project dir: %v", err)
+	}
+	if err := os.WriteFile(config.ConfigFilePath, []byte("post_download_hook: pwd > hook-ran.txt && echo done\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	configManager := config.NewConfigManager(nil)
+	downloader := NewGitDownloader(filesystem.NewManager(), configManager, &MockClient{})
+	var output []string
+
+	// Act
+	err := downloader.completeDownload(context.Background(), "1", projectsDir, "journal_api_1", func(line string) {
+		output = append(output, line)
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(projectDir, "hook-ran.txt"))
+	if err != nil {
+		t.Fatalf("Expected the hook to write into the project directory: %v", err)
+	}
+	wantDir, _ := filepath.EvalSymlinks(projectDir)
+	gotDir, _ := filepath.EvalSymlinks(strings.TrimSpace(string(data)))
+	if gotDir != wantDir {
+		t.Errorf("Expected hook to run in %s, ran in %s", wantDir, gotDir)
+	}
+	if len(output) == 0 || output[len(output)-1] != "done" {
+		t.Errorf("Expected hook output to be streamed, got %v", output)
+	}
+	if !configManager.IsProjectDownloaded("1") {
+		t.Error("Expected the project to be recorded before the hook ran")
+	}
+}
+
+func TestCompleteDownload_HookFailureIsAWarning(t *testing.T) {
+	// Arrange
+	useTempConfig(t)
+	projectsDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(projectsDir, "journal_api_1"), 0755); err != nil {
+		t.Fatalf("Failed to create project dir: %v", err)
+	}
+	if err := os.WriteFile(config.ConfigFilePath, []byte("post_download_hook: exit 3\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	downloader := NewGitDownloader(filesystem.NewManager(), config.NewConfigManager(nil), &MockClient{})
+
+	// Act
+	err := downloader.completeDownload(context.Background(), "1", projectsDir, "journal_api_1", nil)
+
+	// Assert
+	var hookErr *HookError
+	if !errors.As(err, &hookErr) {
+		t.Fatalf("Expected a HookError, got: %v", err)
+	}
+	if !IsWarning(err) {
+		t.Error("Expected a failed hook to be reported as a warning")
+	}
+}
+
+func TestOpenDownloadedProject_RuntimeOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		override *bool
+		wantOpen bool
+	}{
+		{name: "config default opens", config: "{}\n", wantOpen: true},
+		{name: "override skips opening", config: "{}\n", override: boolPtr(false), wantOpen: false},
+		{name: "config disabled skips opening", config: "open_after_download: false\n", wantOpen: false},
+		{name: "override reopens when config disabled", config: "open_after_download: false\n", override: boolPtr(true), wantOpen: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useTempConfig(t)
+			if err := os.WriteFile(config.ConfigFilePath, []byte(tt.config), 0600); err != nil {
+				t.Fatalf("Failed to write test config: %v", err)
+			}
+			downloader := NewGitDownloader(filesystem.NewManager(), config.NewConfigManager(nil), &MockClient{})
+			var opened []string
+			downloader.openExplorer = func(path string) error {
+				opened = append(opened, path)
+				return nil
+			}
+			ctx := context.Background()
+			if tt.override != nil {
+				ctx = WithOpenAfterDownload(ctx, *tt.override)
+			}
+
+			// Act
+			downloader.openDownloadedProject(ctx, "/projects/journal_api_1")
+
+			// Assert
+			if got := len(opened) == 1; got != tt.wantOpen {
+				t.Errorf("Expected explorer opened = %v, got calls %v", tt.wantOpen, opened)
+			}
+		})
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,85 @@
+package downloader
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"sync"
+)
+
+// HookError is returned when the project downloaded but the post-download hook failed.
+// Like InitPendingError it is a warning: the project is usable.
+type HookError struct {
+	Command string
+	Err     error
+}
+
+func (e *HookError) Error() string {
+	return fmt.Sprintf("project downloaded, but the post-download hook %q failed: %v", e.Command, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// IsWarning reports whether err only describes follow-up steps that failed after a
+// successful download (a queued initialization or a failed hook)
+func IsWarning(err error) bool {
+	if err == nil {
+		return false
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			if !IsWarning(e) {
+				return false
+			}
+		}
+		return true
+	}
+	var pending *InitPendingError
+	var hook *HookError
+	return errors.As(err, &pending) || errors.As(err, &hook)
+}
+
+// shellCommand wraps a user-supplied hook in the platform shell
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
+
+// runPostDownloadHook runs the configured hook in dir, streaming combined output to the callback
+func runPostDownloadHook(ctx context.Context, command, dir string, output OutputCallback) error {
+	cmd := shellCommand(ctx, command)
+	cmd.Dir = dir
+
+	reader, writer := io.Pipe()
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		scanner := bufio.NewScanner(reader)
+		for scanner.Scan() {
+			if output != nil {
+				output(scanner.Text())
+			}
+		}
+		_, _ = io.Copy(io.Discard, reader)
+	}()
+
+	err := cmd.Run()
+	_ = writer.Close()
+	wg.Wait()
+	if err != nil {
+		return &HookError{Command: command, Err: err}
+	}
+	return nil
+}
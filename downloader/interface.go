@@ -3,11 +3,29 @@ package downloader
 import (
 	"404skill-cli/api"
 	"context"
+	"fmt"
 )
 
+// openAfterDownloadKey carries a one-off override of the open_after_download setting
+type openAfterDownloadKey struct{}
+
+// WithOpenAfterDownload overrides, for the download using ctx only, whether the file explorer opens afterwards
+func WithOpenAfterDownload(ctx context.Context, open bool) context.Context {
+	return context.WithValue(ctx, openAfterDownloadKey{}, open)
+}
+
+// openAfterDownloadOverride returns the override set with WithOpenAfterDownload, if any
+func openAfterDownloadOverride(ctx context.Context) (open bool, ok bool) {
+	open, ok = ctx.Value(openAfterDownloadKey{}).(bool)
+	return open, ok
+}
+
 // ProgressCallback is called during download operations to report progress
 type ProgressCallback func(progress float64)
 
+// OutputCallback receives post-download hook output one line at a time
+type OutputCallback func(line string)
+
 // Downloader defines the interface for downloading projects
 type Downloader interface {
 	// DownloadProject downloads a project in the specified language
@@ -15,9 +33,35 @@ type Downloader interface {
 	DownloadProject(ctx context.Context, project *api.Project, language string, progressCallback ProgressCallback) error
 }
 
+// OutputDownloader is implemented by downloaders that stream post-download hook output
+type OutputDownloader interface {
+	DownloadProjectWithOutput(ctx context.Context, project *api.Project, language string, progressCallback ProgressCallback, outputCallback OutputCallback) error
+}
+
 // DownloadResult represents the result of a download operation
 type DownloadResult struct {
 	Success   bool
 	Error     error
 	Directory string
 }
+
+// InitPendingError is returned when the project is on disk but registering it with the API failed.
+// The download itself succeeded; the initialization has been queued and will be retried.
+type InitPendingError struct {
+	ProjectID string
+	Err       error
+}
+
+func (e *InitPendingError) Error() string {
+	return fmt.Sprintf("project downloaded, but registering it failed (will retry later): %v", e.Err)
+}
+
+func (e *InitPendingError) Unwrap() error {
+	return e.Err
+}
+
+// InitRetrier is implemented by downloaders that queue failed project initializations
+type InitRetrier interface {
+	// RetryPendingInitializations retries queued initializations and returns how many succeeded
+	RetryPendingInitializations(ctx context.Context) (int, error)
+}
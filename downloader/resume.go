@@ -0,0 +1,120 @@
+package downloader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// InProgressMarkerSuffix names the hidden marker written next to a project while it downloads.
+// A marker that outlives the process means the download was interrupted.
+const InProgressMarkerSuffix = ".download-in-progress"
+
+// IncompleteDownload is a project left behind by an interrupted download
+type IncompleteDownload struct {
+	DirName   string // project directory name, e.g. journal_api_123
+	ProjectID string
+	HasMarker bool // the in-progress marker was found, not just an unrecorded directory
+}
+
+// markerPath returns where the in-progress marker for a project directory lives
+func markerPath(projectsDir, dirName string) string {
+	return filepath.Join(projectsDir, "."+dirName+InProgressMarkerSuffix)
+}
+
+// writeInProgressMarker records that a download into dirName has started
+func writeInProgressMarker(projectsDir, dirName string) error {
+	if err := os.WriteFile(markerPath(projectsDir, dirName), nil, 0644); err != nil {
+		return fmt.Errorf("failed to write download marker: %w", err)
+	}
+	return nil
+}
+
+// clearInProgressMarker removes the marker once the download has been recorded
+func clearInProgressMarker(projectsDir, dirName string) error {
+	if err := os.Remove(markerPath(projectsDir, dirName)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove download marker: %w", err)
+	}
+	return nil
+}
+
+// ProjectIDFromDirName extracts the project ID from a "<repo>_<id>" directory name,
+// the layout downloads are cloned into. It returns "" for names without an ID.
+func ProjectIDFromDirName(dirName string) string {
+	if i := strings.LastIndex(dirName, "_"); i >= 0 {
+		return dirName[i+1:]
+	}
+	return ""
+}
+
+// FindIncompleteDownloads finds projects whose download never finished: a leftover in-progress
+// marker, or a project directory that exists but was never recorded as downloaded.
+func FindIncompleteDownloads(projectsDir string, downloaded map[string]bool) ([]IncompleteDownload, error) {
+	entries, err := os.ReadDir(projectsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read projects directory: %w", err)
+	}
+
+	found := make(map[string]*IncompleteDownload)
+	var order []string
+	add := func(dirName string) *IncompleteDownload {
+		if d, ok := found[dirName]; ok {
+			return d
+		}
+		d := &IncompleteDownload{DirName: dirName, ProjectID: ProjectIDFromDirName(dirName)}
+		found[dirName] = d
+		order = append(order, dirName)
+		return d
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		switch {
+		case !entry.IsDir() && strings.HasPrefix(name, ".") && strings.HasSuffix(name, InProgressMarkerSuffix):
+			add(strings.TrimSuffix(strings.TrimPrefix(name, "."), InProgressMarkerSuffix)).HasMarker = true
+		case entry.IsDir() && !strings.HasPrefix(name, "."):
+			// Only git checkouts look like ours; anything else in the directory is left alone
+			id := ProjectIDFromDirName(name)
+			if id != "" && !downloaded[id] && isDir(filepath.Join(projectsDir, name, ".git")) {
+				add(name)
+			}
+		}
+	}
+
+	incomplete := make([]IncompleteDownload, 0, len(order))
+	for _, dirName := range order {
+		incomplete = append(incomplete, *found[dirName])
+	}
+	return incomplete, nil
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// CleanIncompleteDownload removes a partial project, its test checkout and its marker,
+// so the project can be downloaded again from scratch. Only a directory whose in-progress
+// marker is still on disk is removed: without one it may be a checkout the user made.
+func CleanIncompleteDownload(projectsDir string, download IncompleteDownload) error {
+	if download.DirName == "" || download.DirName == "." || download.DirName == ".." || strings.ContainsAny(download.DirName, `/\`) {
+		return fmt.Errorf("invalid project directory name %q", download.DirName)
+	}
+	if _, err := os.Stat(markerPath(projectsDir, download.DirName)); err != nil {
+		return fmt.Errorf("%s has no interrupted download to clean up", download.DirName)
+	}
+
+	for _, dir := range []string{
+		filepath.Join(projectsDir, download.DirName),
+		filepath.Join(projectsDir, ".tests", download.DirName),
+	} {
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", dir, err)
+		}
+	}
+	return clearInProgressMarker(projectsDir, download.DirName)
+}
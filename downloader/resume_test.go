@@ -0,0 +1,156 @@
+package downloader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInProgressMarker_WriteAndClear(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+
+	// Act
+	if err := writeInProgressMarker(projectsDir, "journal_api_123"); err != nil {
+		t.Fatalf("Expected no error writing marker, got: %v", err)
+	}
+
+	// Assert
+	marker := filepath.Join(projectsDir, ".journal_api_123"+InProgressMarkerSuffix)
+	if _, err := os.Stat(marker); err != nil {
+		t.Fatalf("Expected marker to exist: %v", err)
+	}
+	if err := clearInProgressMarker(projectsDir, "journal_api_123"); err != nil {
+		t.Fatalf("Expected no error clearing marker, got: %v", err)
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Errorf("Expected marker to be removed, stat returned: %v", err)
+	}
+	if err := clearInProgressMarker(projectsDir, "journal_api_123"); err != nil {
+		t.Errorf("Expected clearing a missing marker to be a no-op, got: %v", err)
+	}
+}
+
+func TestFindIncompleteDownloads(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	mustMkdir(t, filepath.Join(projectsDir, "interrupted_1", ".git"))
+	if err := writeInProgressMarker(projectsDir, "interrupted_1"); err != nil {
+		t.Fatalf("Failed to write marker: %v", err)
+	}
+	mustMkdir(t, filepath.Join(projectsDir, "unrecorded_2", ".git"))
+	mustMkdir(t, filepath.Join(projectsDir, "finished_3", ".git"))
+	mustMkdir(t, filepath.Join(projectsDir, "notes_4"))
+	mustMkdir(t, filepath.Join(projectsDir, ".tests", "finished_3"))
+
+	// Act
+	incomplete, err := FindIncompleteDownloads(projectsDir, map[string]bool{"3": true})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	byDir := make(map[string]IncompleteDownload)
+	for _, d := range incomplete {
+		byDir[d.DirName] = d
+	}
+	if len(byDir) != 2 {
+		t.Fatalf("Expected 2 incomplete downloads, got %+v", incomplete)
+	}
+	if d := byDir["interrupted_1"]; !d.HasMarker || d.ProjectID != "1" {
+		t.Errorf("Expected interrupted_1 with a marker, got %+v", d)
+	}
+	if d, ok := byDir["unrecorded_2"]; !ok || d.HasMarker || d.ProjectID != "2" {
+		t.Errorf("Expected unrecorded_2 without a marker, got %+v", d)
+	}
+}
+
+func TestFindIncompleteDownloads_MissingDir(t *testing.T) {
+	// Act
+	incomplete, err := FindIncompleteDownloads(filepath.Join(t.TempDir(), "missing"), nil)
+
+	// Assert
+	if err != nil || len(incomplete) != 0 {
+		t.Errorf("Expected nothing for a missing projects dir, got %v, %v", incomplete, err)
+	}
+}
+
+func TestCleanIncompleteDownload(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	mustMkdir(t, filepath.Join(projectsDir, "interrupted_1", ".git"))
+	mustMkdir(t, filepath.Join(projectsDir, ".tests", "interrupted_1"))
+	if err := writeInProgressMarker(projectsDir, "interrupted_1"); err != nil {
+		t.Fatalf("Failed to write marker: %v", err)
+	}
+
+	// Act
+	err := CleanIncompleteDownload(projectsDir, IncompleteDownload{DirName: "interrupted_1", ProjectID: "1", HasMarker: true})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	remaining, err := FindIncompleteDownloads(projectsDir, nil)
+	if err != nil || len(remaining) != 0 {
+		t.Errorf("Expected nothing left to clean, got %v, %v", remaining, err)
+	}
+	if _, err := os.Stat(filepath.Join(projectsDir, ".tests", "interrupted_1")); !os.IsNotExist(err) {
+		t.Errorf("Expected the test checkout to be removed, stat returned: %v", err)
+	}
+}
+
+func TestCleanIncompleteDownload_RejectsPathsOutsideProjectsDir(t *testing.T) {
+	for _, name := range []string{"", ".", "..", "../etc", "a/b"} {
+		if err := CleanIncompleteDownload(t.TempDir(), IncompleteDownload{DirName: name}); err == nil {
+			t.Errorf("Expected %q to be rejected", name)
+		}
+	}
+}
+
+func TestCleanIncompleteDownload_KeepsCheckoutsWithoutMarker(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	mustMkdir(t, filepath.Join(projectsDir, "my_clone_7", ".git"))
+
+	// Act
+	err := CleanIncompleteDownload(projectsDir, IncompleteDownload{DirName: "my_clone_7", ProjectID: "7", HasMarker: true})
+
+	// Assert
+	if err == nil {
+		t.Error("Expected a checkout without a marker to be refused")
+	}
+	if _, err := os.Stat(filepath.Join(projectsDir, "my_clone_7", ".git")); err != nil {
+		t.Errorf("Expected the checkout to be kept, stat returned: %v", err)
+	}
+}
+
+func TestAbandonDownload_RemovesPartialCloneAndMarker(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	if err := writeInProgressMarker(projectsDir, "journal_api_1"); err != nil {
+		t.Fatalf("Failed to write marker: %v", err)
+	}
+	mustMkdir(t, filepath.Join(projectsDir, "journal_api_1", ".git"))
+	cloneErr := errors.New("clone cancelled")
+
+	// Act
+	err := abandonDownload(projectsDir, "journal_api_1", cloneErr)
+
+	// Assert
+	if !errors.Is(err, cloneErr) {
+		t.Errorf("Expected the clone error to be returned, got %v", err)
+	}
+	remaining, err := FindIncompleteDownloads(projectsDir, nil)
+	if err != nil || len(remaining) != 0 {
+		t.Errorf("Expected neither the clone nor its marker to be left, got %v, %v", remaining, err)
+	}
+}
+
+func mustMkdir(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(path, 0755); err != nil {
+		t.Fatalf("Failed to create %s: %v", path, err)
+	}
+}
@@ -1,13 +1,31 @@
 package filesystem
 
 import (
+	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
+	"strings"
+
+	"github.com/atotto/clipboard"
 )
 
 // Manager handles file system operations
-type Manager struct{}
+type Manager struct {
+	editor   string                            // configured editor command, may include arguments
+	lookPath func(file string) (string, error) // replaced in tests
+}
+
+// editorCandidates are looked up on PATH, in order, when no editor is configured
+var editorCandidates = []string{"code", "idea"}
+
+// terminalEditors draw inside the terminal they are started from instead of opening a window
+var terminalEditors = map[string]bool{
+	"vi": true, "vim": true, "nvim": true, "nano": true, "pico": true, "emacs": true,
+	"micro": true, "hx": true, "helix": true, "kak": true, "joe": true, "mg": true, "ed": true,
+}
 
 // NewManager creates a new filesystem manager
 func NewManager() *Manager {
@@ -25,7 +43,93 @@ func (f *Manager) OpenFileExplorer(path string) error {
 	default: // "linux", "freebsd", "openbsd", "netbsd"
 		cmd = exec.Command("xdg-open", path)
 	}
-	return cmd.Start()
+	return startDetached(cmd)
+}
+
+// OpenURL opens a web page in the default browser. Only http and https addresses are opened.
+func (f *Manager) OpenURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("not a web address: %q", rawURL)
+	}
+	name, args := openURLCommand(runtime.GOOS, u.String())
+	return startDetached(exec.Command(name, args...))
+}
+
+// openURLCommand builds the command that hands a URL to the browser on the given OS. Windows
+// goes through rundll32 rather than "cmd /c start", which would split the URL at any "&".
+func openURLCommand(goos, url string) (string, []string) {
+	switch goos {
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}
+	case "darwin":
+		return "open", []string{url}
+	default: // "linux", "freebsd", "openbsd", "netbsd"
+		return "xdg-open", []string{url}
+	}
+}
+
+// SetEditor sets the editor command used by OpenInEditor, e.g. "code" or "idea --wait"
+func (f *Manager) SetEditor(editor string) {
+	f.editor = strings.TrimSpace(editor)
+}
+
+// EditorCommand builds the command that opens path in the user's editor: the configured
+// editor, then $EDITOR, then the first of code or idea found on PATH. It returns nil when
+// no editor is available.
+func (f *Manager) EditorCommand(path string) *exec.Cmd {
+	editor := f.editor
+	if editor == "" {
+		editor = strings.TrimSpace(os.Getenv("EDITOR"))
+	}
+	if editor == "" {
+		lookPath := f.lookPath
+		if lookPath == nil {
+			lookPath = exec.LookPath
+		}
+		for _, candidate := range editorCandidates {
+			if _, err := lookPath(candidate); err == nil {
+				editor = candidate
+				break
+			}
+		}
+	}
+	if editor == "" {
+		return nil
+	}
+
+	args := strings.Fields(editor)
+	return exec.Command(args[0], append(args[1:], path)...)
+}
+
+// IsTerminalEditor reports whether cmd runs an editor inside the terminal, which it needs to
+// itself until it exits
+func IsTerminalEditor(cmd *exec.Cmd) bool {
+	name := strings.TrimSuffix(filepath.Base(cmd.Args[0]), ".exe")
+	return terminalEditors[name]
+}
+
+// OpenInEditor opens path in the user's editor, falling back to the file explorer when none is
+// configured. A terminal editor is refused: it has to run in the foreground, e.g. through
+// tea.ExecProcess with EditorCommand.
+func (f *Manager) OpenInEditor(path string) error {
+	cmd := f.EditorCommand(path)
+	if cmd == nil {
+		return f.OpenFileExplorer(path)
+	}
+	if IsTerminalEditor(cmd) {
+		return fmt.Errorf("%s runs in the terminal and can't be opened in the background", cmd.Args[0])
+	}
+	return startDetached(cmd)
+}
+
+// startDetached starts cmd without waiting for it, and reaps it in the background once it exits
+func startDetached(cmd *exec.Cmd) error {
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
 }
 
 // CreateDirectory creates a directory if it doesn't exist
@@ -46,3 +150,8 @@ func (f *Manager) DirectoryExists(path string) bool {
 	}
 	return info.IsDir()
 }
+
+// CopyToClipboard places text on the system clipboard
+func (f *Manager) CopyToClipboard(text string) error {
+	return clipboard.WriteAll(text)
+}
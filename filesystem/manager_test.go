@@ -2,8 +2,10 @@ package filesystem
 
 import (
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 )
 
@@ -310,3 +312,133 @@ func TestManager_IntegrationTest(t *testing.T) {
 		t.Error("Expected directory to not exist after removal")
 	}
 }
+
+// TestManager_EditorCommand tests how the editor launch command is resolved
+func TestManager_EditorCommand(t *testing.T) {
+	notFound := func(file string) (string, error) { return "", exec.ErrNotFound }
+	onlyIdea := func(file string) (string, error) {
+		if file == "idea" {
+			return "/usr/local/bin/idea", nil
+		}
+		return "", exec.ErrNotFound
+	}
+
+	tests := []struct {
+		name       string
+		configured string
+		envEditor  string
+		lookPath   func(string) (string, error)
+		wantArgs   []string
+	}{
+		{"configured editor with arguments", "code --new-window", "vim", notFound, []string{"code", "--new-window", "/p"}},
+		{"falls back to EDITOR", "", "nvim", onlyIdea, []string{"nvim", "/p"}},
+		{"detects editor on PATH", "", "", onlyIdea, []string{"idea", "/p"}},
+		{"no editor available", "", "", notFound, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv("EDITOR", tt.envEditor)
+			manager := &Manager{lookPath: tt.lookPath}
+			manager.SetEditor(tt.configured)
+
+			// Act
+			cmd := manager.EditorCommand("/p")
+
+			// Assert
+			if tt.wantArgs == nil {
+				if cmd != nil {
+					t.Errorf("Expected no editor command, got %v", cmd.Args)
+				}
+				return
+			}
+			if cmd == nil {
+				t.Fatalf("Expected editor command %v, got nil", tt.wantArgs)
+			}
+			if strings.Join(cmd.Args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("Expected args %v, got %v", tt.wantArgs, cmd.Args)
+			}
+		})
+	}
+}
+
+// TestIsTerminalEditor tests which editors need the terminal to themselves
+func TestIsTerminalEditor(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"vim", "/p"}, true},
+		{[]string{"/usr/bin/nano", "/p"}, true},
+		{[]string{"nvim.exe", "/p"}, true},
+		{[]string{"code", "--new-window", "/p"}, false},
+		{[]string{"idea", "/p"}, false},
+	}
+
+	for _, tt := range tests {
+		cmd := exec.Command(tt.args[0], tt.args[1:]...)
+		if got := IsTerminalEditor(cmd); got != tt.want {
+			t.Errorf("IsTerminalEditor(%v) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+// TestManager_OpenInEditor_RefusesTerminalEditor tests that a terminal editor is never started in the background
+func TestManager_OpenInEditor_RefusesTerminalEditor(t *testing.T) {
+	// Arrange
+	manager := NewManager()
+	manager.SetEditor("vim")
+
+	// Act
+	err := manager.OpenInEditor(t.TempDir())
+
+	// Assert
+	if err == nil {
+		t.Error("Expected a terminal editor to be refused")
+	}
+}
+
+// TestOpenURLCommand tests the browser command built for each OS
+func TestOpenURLCommand(t *testing.T) {
+	const page = "https://404skill.dev/projects/p1?tab=tasks&lang=go"
+	tests := []struct {
+		goos     string
+		wantName string
+		wantArgs []string
+	}{
+		{"darwin", "open", []string{page}},
+		{"windows", "rundll32", []string{"url.dll,FileProtocolHandler", page}},
+		{"linux", "xdg-open", []string{page}},
+		{"freebsd", "xdg-open", []string{page}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.goos, func(t *testing.T) {
+			// Act
+			name, args := openURLCommand(tt.goos, page)
+
+			// Assert
+			if name != tt.wantName {
+				t.Errorf("Expected command %q, got %q", tt.wantName, name)
+			}
+			if strings.Join(args, " ") != strings.Join(tt.wantArgs, " ") {
+				t.Errorf("Expected args %v, got %v", tt.wantArgs, args)
+			}
+		})
+	}
+}
+
+// TestManager_OpenURL_RejectsNonWebAddresses tests that only http and https URLs are opened
+func TestManager_OpenURL_RejectsNonWebAddresses(t *testing.T) {
+	manager := NewManager()
+	for _, raw := range []string{"", "file:///etc/passwd", "/tmp/project", "https://"} {
+		// Act
+		err := manager.OpenURL(raw)
+
+		// Assert
+		if err == nil {
+			t.Errorf("Expected an error opening %q", raw)
+		}
+	}
+}
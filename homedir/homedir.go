@@ -0,0 +1,51 @@
+// Package homedir resolves the directory the CLI keeps its config, projects and traces under.
+package homedir
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Env overrides the home directory, e.g. in containers and CI jobs where HOME is unset
+const Env = "FOURSKILL_HOME"
+
+// fallbackDirName is created in the temp directory when no home directory is known
+const fallbackDirName = "404skill-home"
+
+var (
+	// userHomeDir and warnOutput are replaced in tests
+	userHomeDir           = os.UserHomeDir
+	warnOutput  io.Writer = os.Stderr
+	warnOnce    sync.Once
+)
+
+// Dir returns the home directory: $FOURSKILL_HOME when set, otherwise the user's home. When
+// neither is available it falls back to a directory under the temp dir and warns once, since
+// anything stored there may not survive a reboot. FOURSKILL_HOME must be an absolute path.
+func Dir() (string, error) {
+	if override := os.Getenv(Env); override != "" {
+		if !filepath.IsAbs(override) {
+			return "", fmt.Errorf("%s must be an absolute path, got %q", Env, override)
+		}
+		return filepath.Clean(override), nil
+	}
+
+	home, err := userHomeDir()
+	if err == nil && home != "" {
+		return home, nil
+	}
+
+	fallback := filepath.Join(os.TempDir(), fallbackDirName)
+	warnOnce.Do(func() {
+		reason := "no home directory is set"
+		if err != nil {
+			reason = err.Error()
+		}
+		fmt.Fprintf(warnOutput, "warning: %s; using %s instead. Set %s to keep settings and projects somewhere permanent.\n",
+			reason, fallback, Env)
+	})
+	return fallback, nil
+}
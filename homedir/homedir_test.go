@@ -0,0 +1,111 @@
+package homedir
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// captureWarnings collects warnings and re-arms the one-time warning for the duration of a test
+func captureWarnings(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	original := warnOutput
+	warnOutput = &buf
+	warnOnce = sync.Once{}
+	t.Cleanup(func() {
+		warnOutput = original
+		warnOnce = sync.Once{}
+	})
+	return &buf
+}
+
+// stubUserHomeDir simulates the platform's home lookup, e.g. failing as it does when HOME is unset
+func stubUserHomeDir(t *testing.T, home string, err error) {
+	t.Helper()
+	original := userHomeDir
+	userHomeDir = func() (string, error) { return home, err }
+	t.Cleanup(func() { userHomeDir = original })
+}
+
+func TestDir_UsesUserHome(t *testing.T) {
+	// Arrange
+	home := t.TempDir()
+	t.Setenv(Env, "")
+	stubUserHomeDir(t, home, nil)
+	warnings := captureWarnings(t)
+
+	// Act
+	dir, err := Dir()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if dir != home {
+		t.Errorf("Expected %s, got %s", home, dir)
+	}
+	if warnings.Len() != 0 {
+		t.Errorf("Expected no warning, got %q", warnings.String())
+	}
+}
+
+func TestDir_EnvOverrideWins(t *testing.T) {
+	// Arrange
+	override := t.TempDir()
+	t.Setenv(Env, override)
+	stubUserHomeDir(t, "", errors.New("$HOME is not defined"))
+
+	// Act
+	dir, err := Dir()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if dir != override {
+		t.Errorf("Expected %s, got %s", override, dir)
+	}
+}
+
+func TestDir_RelativeEnvOverrideIsAnError(t *testing.T) {
+	// Arrange
+	t.Setenv(Env, "relative/home")
+
+	// Act
+	_, err := Dir()
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), Env) {
+		t.Errorf("Expected an error naming %s, got: %v", Env, err)
+	}
+}
+
+func TestDir_UnsetHomeFallsBackToTempDirWithWarning(t *testing.T) {
+	// Arrange
+	t.Setenv(Env, "")
+	stubUserHomeDir(t, "", errors.New("$HOME is not defined"))
+	warnings := captureWarnings(t)
+
+	// Act
+	dir, err := Dir()
+	_, _ = Dir()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected a fallback instead of an error, got: %v", err)
+	}
+	if dir != filepath.Join(os.TempDir(), fallbackDirName) {
+		t.Errorf("Expected the temp dir fallback, got %s", dir)
+	}
+	if !strings.Contains(warnings.String(), "$HOME is not defined") || !strings.Contains(warnings.String(), Env) {
+		t.Errorf("Expected a warning explaining the fallback, got %q", warnings.String())
+	}
+	if strings.Count(warnings.String(), "warning:") != 1 {
+		t.Errorf("Expected the warning once, got %q", warnings.String())
+	}
+}
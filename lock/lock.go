@@ -0,0 +1,119 @@
+// Package lock prevents two CLI sessions from writing the same config at once.
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"404skill-cli/config"
+)
+
+// ErrHeld is returned when another live process holds the lock
+var ErrHeld = errors.New("another 404skill session appears to be running")
+
+// HeldError reports which process holds the lock
+type HeldError struct {
+	PID  int
+	Path string
+}
+
+func (e *HeldError) Error() string {
+	return fmt.Sprintf("%v (pid %d); if it isn't, delete %s", ErrHeld, e.PID, e.Path)
+}
+
+func (e *HeldError) Unwrap() error {
+	return ErrHeld
+}
+
+// Lock is a PID lock file
+type Lock struct {
+	path    string
+	pid     int
+	isAlive func(pid int) bool // reports whether a process is still running
+}
+
+// DefaultPath returns the lock file next to the config file (~/.404skill/.lock)
+func DefaultPath() string {
+	return filepath.Join(filepath.Dir(config.ConfigFilePath), ".lock")
+}
+
+// New creates a lock for the current process at path
+func New(path string) *Lock {
+	return &Lock{
+		path:    path,
+		pid:     os.Getpid(),
+		isAlive: processAlive,
+	}
+}
+
+// Acquire takes the lock, reclaiming it if the process that held it no longer exists
+func (l *Lock) Acquire() error {
+	for attempt := 0; attempt < 2; attempt++ {
+		err := l.create()
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("failed to create lock file: %w", err)
+		}
+
+		holder, err := l.holder()
+		if err == nil && holder != l.pid && l.isAlive(holder) {
+			return &HeldError{PID: holder, Path: l.path}
+		}
+
+		// Stale or unreadable lock, or one left by this process: reclaim it
+		if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove stale lock file: %w", err)
+		}
+	}
+	return fmt.Errorf("failed to acquire lock file %s", l.path)
+}
+
+// Release removes the lock if this process still holds it
+func (l *Lock) Release() error {
+	holder, err := l.holder()
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err == nil && holder != l.pid {
+		return nil // reclaimed by another session, leave it alone
+	}
+	if err := os.Remove(l.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove lock file: %w", err)
+	}
+	return nil
+}
+
+// create writes this process's PID to a new lock file, failing if one already exists
+func (l *Lock) create() error {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(l.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(strconv.Itoa(l.pid))
+	return err
+}
+
+// holder returns the PID recorded in the lock file
+func (l *Lock) holder() (int, error) {
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		return 0, err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid lock file contents: %w", err)
+	}
+	return pid, nil
+}
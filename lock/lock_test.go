@@ -0,0 +1,148 @@
+package lock
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// newTestLock creates a lock in a temp dir with a stubbed liveness check
+func newTestLock(t *testing.T, pid int, alive map[int]bool) *Lock {
+	t.Helper()
+	return &Lock{
+		path:    filepath.Join(t.TempDir(), ".404skill", ".lock"),
+		pid:     pid,
+		isAlive: func(pid int) bool { return alive[pid] },
+	}
+}
+
+func writeLockFile(t *testing.T, path string, pid int) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create lock dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(strconv.Itoa(pid)), 0600); err != nil {
+		t.Fatalf("Failed to write lock file: %v", err)
+	}
+}
+
+func TestLock_Acquire(t *testing.T) {
+	// Arrange
+	lock := newTestLock(t, 100, nil)
+
+	// Act
+	err := lock.Acquire()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	data, err := os.ReadFile(lock.path)
+	if err != nil || string(data) != "100" {
+		t.Errorf("Expected lock file to hold pid 100, got %q (%v)", data, err)
+	}
+}
+
+func TestLock_Acquire_HeldByLiveProcess(t *testing.T) {
+	// Arrange
+	lock := newTestLock(t, 100, map[int]bool{200: true})
+	writeLockFile(t, lock.path, 200)
+
+	// Act
+	err := lock.Acquire()
+
+	// Assert
+	if !errors.Is(err, ErrHeld) {
+		t.Fatalf("Expected ErrHeld, got: %v", err)
+	}
+	var held *HeldError
+	if !errors.As(err, &held) || held.PID != 200 {
+		t.Errorf("Expected holder pid 200, got %v", err)
+	}
+	if data, _ := os.ReadFile(lock.path); string(data) != "200" {
+		t.Errorf("Expected the other session's lock to be untouched, got %q", data)
+	}
+}
+
+func TestLock_Acquire_ReclaimsStaleLock(t *testing.T) {
+	// Arrange
+	lock := newTestLock(t, 100, map[int]bool{})
+	writeLockFile(t, lock.path, 200)
+
+	// Act
+	err := lock.Acquire()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected stale lock to be reclaimed, got: %v", err)
+	}
+	if data, _ := os.ReadFile(lock.path); string(data) != "100" {
+		t.Errorf("Expected lock file to hold pid 100, got %q", data)
+	}
+}
+
+func TestLock_Acquire_ReclaimsCorruptLock(t *testing.T) {
+	// Arrange
+	lock := newTestLock(t, 100, nil)
+	if err := os.MkdirAll(filepath.Dir(lock.path), 0755); err != nil {
+		t.Fatalf("Failed to create lock dir: %v", err)
+	}
+	if err := os.WriteFile(lock.path, []byte("not a pid"), 0600); err != nil {
+		t.Fatalf("Failed to write lock file: %v", err)
+	}
+
+	// Act & Assert
+	if err := lock.Acquire(); err != nil {
+		t.Fatalf("Expected corrupt lock to be reclaimed, got: %v", err)
+	}
+}
+
+func TestLock_Release(t *testing.T) {
+	// Arrange
+	lock := newTestLock(t, 100, nil)
+	if err := lock.Acquire(); err != nil {
+		t.Fatalf("Failed to acquire lock: %v", err)
+	}
+
+	// Act
+	err := lock.Release()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if _, err := os.Stat(lock.path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected lock file to be removed, got: %v", err)
+	}
+	if err := lock.Release(); err != nil {
+		t.Errorf("Expected releasing twice to be a no-op, got: %v", err)
+	}
+}
+
+func TestLock_Release_LeavesOtherSessionsLock(t *testing.T) {
+	// Arrange
+	lock := newTestLock(t, 100, nil)
+	writeLockFile(t, lock.path, 200)
+
+	// Act
+	err := lock.Release()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if data, _ := os.ReadFile(lock.path); string(data) != "200" {
+		t.Errorf("Expected another session's lock to be kept, got %q", data)
+	}
+}
+
+func TestProcessAlive(t *testing.T) {
+	if !processAlive(os.Getpid()) {
+		t.Error("Expected the current process to be alive")
+	}
+	if processAlive(0) || processAlive(-1) {
+		t.Error("Expected invalid pids to be reported as not running")
+	}
+}
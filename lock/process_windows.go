@@ -0,0 +1,19 @@
+//go:build windows
+
+package lock
+
+import "os"
+
+// processAlive reports whether a process with the given PID exists
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	// On Windows FindProcess opens a handle and fails if the process is gone
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	_ = process.Release()
+	return true
+}
@@ -4,11 +4,19 @@ import (
 	"404skill-cli/api"
 	"404skill-cli/auth"
 	"404skill-cli/config"
+	"404skill-cli/doctor"
+	"404skill-cli/downloader"
+	"404skill-cli/lock"
 	"404skill-cli/supabase"
+	"404skill-cli/testrunner"
 	"404skill-cli/tracing"
 	"404skill-cli/tui"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -20,6 +28,46 @@ var (
 )
 
 func main() {
+	// os.Exit skips deferred calls, so everything that must happen on shutdown lives in run
+	os.Exit(run())
+}
+
+// run starts the CLI and returns the process exit code
+func run() int {
+	projectsDir := flag.String("projects-dir", "", "download and test projects in this directory for this run (overrides config and "+config.ProjectsDirEnv+")")
+	debugTests := flag.Bool("debug-tests", false, "run tests with per-language verbosity flags and keep all container output; the flags are passed as $"+testrunner.DebugArgsEnv+", which docker-compose.test.yml must append to the test command (overrides config and "+config.DebugTestsEnv+")")
+	demo := flag.Bool("demo", false, "show the TUI with sample projects and canned results, without logging in or touching the network")
+	flag.Parse()
+	config.ProjectsDirOverride = *projectsDir
+	config.DebugTestsOverride = *debugTests
+
+	if *demo {
+		return runDemo()
+	}
+
+	if err := config.Init(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	args, err := resolveAliases(flag.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	// Subcommands print and exit without starting the TUI or taking the session lock
+	if len(args) > 0 {
+		switch args[0] {
+		case "config":
+			return runConfigCommand()
+		case "adopt":
+			return runAdoptCommand(args[1:])
+		case "doctor":
+			return runDoctorCommand(args[1:])
+		}
+	}
+
 	// Initialize tracing system
 	tracingConfig := tracing.DefaultConfig()
 	tracingConfig.LocalDir = "~/.404skill/traces"
@@ -32,10 +80,26 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Warning: Failed to initialize tracing: %v\n", err)
 	}
 
-	// Ensure tracing is properly closed on exit
+	// Ensure traces are flushed and tracing is closed on every exit path
+	exitTrigger := "error_shutdown"
 	defer func() {
-		if err := tracing.CloseGlobalTracing(); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: Failed to close tracing: %v\n", err)
+		shutdownTracing(exitTrigger)
+	}()
+
+	// Refuse to run alongside another session so the config isn't written by both
+	instanceLock := lock.New(lock.DefaultPath())
+	if err := instanceLock.Acquire(); err != nil {
+		_ = tracing.TrackError(err, "main")
+		if errors.Is(err, lock.ErrHeld) {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		} else {
+			fmt.Fprintf(os.Stderr, "Error acquiring session lock: %v\n", err)
+		}
+		return 1
+	}
+	defer func() {
+		if err := instanceLock.Release(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: Failed to release session lock: %v\n", err)
 		}
 	}()
 
@@ -43,25 +107,24 @@ func main() {
 	startupTracker := tracing.TimedOperation("application_startup")
 	startupTracker.AddMetadata("version", version)
 
-	// Create auth dependencies
-	supabaseClient, err := supabase.NewSupabaseClient()
-	if err != nil {
-		_ = tracing.TrackError(err, "main")
-		fmt.Fprintf(os.Stderr, "Error creating Supabase client: %v\n", err)
-		os.Exit(1)
+	// Transport settings are read before auth is wired up, since login goes through the same client
+	clientOptions := api.ClientOptionsFromConfig(config.NewConfigManager(nil))
+	if clientOptions.ProxyURL != "" {
+		startupTracker.AddMetadata("api_proxy", api.RedactProxyURL(clientOptions.ProxyURL))
+	}
+	if clientOptions.CACertPath != "" {
+		startupTracker.AddMetadata("api_ca_cert", clientOptions.CACertPath)
+	}
+	if clientOptions.InsecureSkipVerify {
+		startupTracker.AddMetadata("api_insecure_skip_verify", "true")
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", api.InsecureWarning)
 	}
 
-	authProvider := auth.NewSupabaseAuth(supabaseClient)
-	configWriter := config.SimpleConfigWriter{}
-	authService := auth.NewAuthService(authProvider, &configWriter)
-
-	// Create API client with config manager as token provider
-	configManager := config.NewConfigManager(authService)
-	client, err := api.NewClient(configManager)
+	client, _, err := newAPIClient(clientOptions)
 	if err != nil {
 		_ = tracing.TrackError(err, "main")
-		fmt.Fprintf(os.Stderr, "Error creating API client: %v\n", err)
-		os.Exit(1)
+		fmt.Fprintf(os.Stderr, "Error %v\n", err)
+		return 1
 	}
 
 	// Initialize the TUI model
@@ -69,7 +132,7 @@ func main() {
 	if err != nil {
 		_ = tracing.TrackError(err, "main")
 		fmt.Fprintf(os.Stderr, "Error initializing TUI: %v\n", err)
-		os.Exit(1)
+		return 1
 	}
 
 	// Complete startup tracking
@@ -82,9 +145,192 @@ func main() {
 	p := tea.NewProgram(model, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		_ = tracing.TrackError(err, "main")
-		os.Exit(1)
+		return 1
+	}
+
+	exitTrigger = "normal_shutdown"
+	return 0
+}
+
+// subcommands are the built-in subcommand names; aliases can point at them but never replace them
+var subcommands = []string{"config", "adopt", "doctor"}
+
+// resolveAliases expands a user-defined alias from the config into its subcommand, warning
+// about aliases that would shadow a built-in
+func resolveAliases(args []string) ([]string, error) {
+	aliases := config.NewConfigManager(nil).GetAliases()
+	for _, alias := range config.ShadowedAliases(aliases, subcommands) {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring alias %q, it has the same name as a built-in subcommand\n", alias)
+	}
+	return config.ResolveAlias(args, aliases, subcommands)
+}
+
+// runConfigCommand prints the effective configuration with the source of each value
+func runConfigCommand() int {
+	values := config.NewConfigManager(nil).EffectiveConfig()
+	if err := config.WriteEffectiveConfig(os.Stdout, values); err != nil {
+		fmt.Fprintf(os.Stderr, "Error printing configuration: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// runDoctorCommand checks the environment and prints a checklist, or JSON with --json. It
+// exits non-zero when a check fails, so setup scripts can rely on it.
+func runDoctorCommand(args []string) int {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "print the results as JSON")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	configManager := config.NewConfigManager(nil)
+	runner := testrunner.NewDefaultTestRunner()
+	report := doctor.Run(doctor.DefaultChecks(configManager, runner))
+
+	write := doctor.WriteText
+	if *asJSON {
+		write = doctor.WriteJSON
+	}
+	if err := write(os.Stdout, report); err != nil {
+		fmt.Fprintf(os.Stderr, "Error printing doctor report: %v\n", err)
+		return 1
+	}
+	if !report.Healthy {
+		return 1
+	}
+	return 0
+}
+
+// newAPIClient wires up auth and returns an API client, with the config manager it gets
+// tokens from
+func newAPIClient(clientOptions api.ClientOptions) (*api.Client, *config.ConfigManager, error) {
+	httpClient, err := api.NewHTTPClient(clientOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("configuring HTTP client: %w", err)
 	}
 
-	// Track application exit
-	_ = tracing.TrackStateTransition("tui_active", "application_exit", "normal_shutdown")
+	// Create auth dependencies
+	supabaseClient, err := supabase.NewSupabaseClientWithHTTPClient(httpClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating Supabase client: %w", err)
+	}
+
+	authProvider := auth.NewSupabaseAuth(supabaseClient)
+	configWriter := config.SimpleConfigWriter{}
+	authService := auth.NewAuthService(authProvider, &configWriter)
+
+	// Create API client with config manager as token provider
+	configManager := config.NewConfigManager(authService)
+	client, err := api.NewClientWithOptions(configManager, clientOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating API client: %w", err)
+	}
+	return client, configManager, nil
+}
+
+// runAdoptCommand marks projects cloned by hand as downloaded. Without arguments it lists
+// the project directories the config doesn't know about.
+func runAdoptCommand(dirs []string) int {
+	client, configManager, err := newAPIClient(api.ClientOptionsFromConfig(config.NewConfigManager(nil)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error %v\n", err)
+		return 1
+	}
+	projectsDir, err := configManager.GetProjectsDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error resolving projects directory: %v\n", err)
+		return 1
+	}
+
+	// Only directories named like a project the API knows can be adopted
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	projects, err := client.ListProjects(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching projects: %v\n", err)
+		return 1
+	}
+
+	if len(dirs) == 0 {
+		adoptable, err := downloader.FindAdoptable(projectsDir, configManager.GetDownloadedProjects())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error scanning %s: %v\n", projectsDir, err)
+			return 1
+		}
+		candidates := downloader.MatchAdoptable(adoptable, projects)
+		if len(candidates) == 0 {
+			fmt.Printf("No unrecorded project in %s is named like a 404skill project.\n", projectsDir)
+			return 0
+		}
+		fmt.Printf("Projects in %s not marked as downloaded:\n", projectsDir)
+		for _, candidate := range candidates {
+			fmt.Printf("  %s (%s)\n", candidate.DirName, candidate.Project.Name)
+		}
+		fmt.Println("Run `404skill adopt <dir>...` to mark them as downloaded.")
+		return 0
+	}
+
+	// Adopting writes the config, so it must not race a running session
+	instanceLock := lock.New(lock.DefaultPath())
+	if err := instanceLock.Acquire(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	defer func() {
+		_ = instanceLock.Release()
+	}()
+
+	exitCode := 0
+	for _, dir := range dirs {
+		project, err := downloader.AdoptProject(configManager, projectsDir, dir, projects)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error adopting %s: %v\n", dir, err)
+			exitCode = 1
+			continue
+		}
+		fmt.Printf("Marked %s (%s) as downloaded\n", filepath.Base(dir), project.Name)
+	}
+	return exitCode
+}
+
+// runDemo runs the TUI against built-in sample data. Everything it writes goes to a
+// throwaway config and projects directory, so the user's real setup is never touched.
+func runDemo() int {
+	dir, err := os.MkdirTemp("", "404skill-demo-")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating demo directory: %v\n", err)
+		return 1
+	}
+	defer os.RemoveAll(dir)
+
+	config.ConfigFilePath = filepath.Join(dir, "config.yml")
+	config.ProjectsDirOverride = filepath.Join(dir, "projects")
+	if err := os.WriteFile(config.ConfigFilePath, []byte("{}\n"), 0600); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating demo config: %v\n", err)
+		return 1
+	}
+
+	model, err := tui.InitialDemoModel(version)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing TUI: %v\n", err)
+		return 1
+	}
+	if _, err := tea.NewProgram(model, tea.WithAltScreen()).Run(); err != nil {
+		return 1
+	}
+	return 0
+}
+
+// shutdownTracing records the exit, then flushes and closes tracing, in that order,
+// so the final navigation and performance events reach disk before the process exits
+func shutdownTracing(trigger string) {
+	_ = tracing.TrackStateTransition("tui_active", "application_exit", trigger)
+
+	if err := tracing.FlushGlobalTracing(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to flush tracing: %v\n", err)
+	}
+	if err := tracing.CloseGlobalTracing(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Failed to close tracing: %v\n", err)
+	}
 }
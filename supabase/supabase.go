@@ -2,6 +2,7 @@ package supabase
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/joho/godotenv"
@@ -15,6 +16,24 @@ var (
 )
 
 func NewSupabaseClient() (*supabase.Client, error) {
+	return NewSupabaseClientWithHTTPClient(nil)
+}
+
+// NewSupabaseClientWithHTTPClient creates a Supabase client whose auth requests go through httpClient,
+// so proxy and custom CA settings apply to login as well. A nil httpClient uses the library default.
+func NewSupabaseClientWithHTTPClient(httpClient *http.Client) (*supabase.Client, error) {
+	client, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if httpClient != nil {
+		client.Auth = client.Auth.WithClient(*httpClient)
+	}
+	return client, nil
+}
+
+func newClient() (*supabase.Client, error) {
 	if embeddedSupabaseURL != "" && embeddedSupabaseKey != "" {
 		return supabase.NewClient(embeddedSupabaseURL, embeddedSupabaseKey, nil)
 	}
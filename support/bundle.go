@@ -0,0 +1,212 @@
+package support
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"404skill-cli/config"
+	"404skill-cli/homedir"
+)
+
+// redacted replaces anything scrubbed from a support bundle
+const redacted = "<redacted>"
+
+// BundleOptions describes what goes into a support bundle
+type BundleOptions struct {
+	Version   string
+	SessionID string
+	Config    []config.EffectiveValue // already redacted by EffectiveConfig
+	Secrets   []string                // literal values scrubbed from every file, e.g. the password and token
+	LogPath   string                  // latest test log; left out when empty or unreadable
+	TracesDir string                  // the trace session for SessionID, or the newest one, is included
+	Now       time.Time
+}
+
+// WriteBundle writes a redacted support bundle zip into dir and returns its path.
+// It contains environment.txt, config.txt and, when available, test.log and trace-session.json.
+func WriteBundle(dir string, opts BundleOptions) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+
+	r := newRedactor(opts.Secrets)
+	files := map[string]string{}
+
+	var cfg bytes.Buffer
+	if err := config.WriteEffectiveConfig(&cfg, opts.Config); err != nil {
+		return "", fmt.Errorf("failed to format config: %w", err)
+	}
+	files["config.txt"] = cfg.String()
+
+	logStatus := "not found"
+	if opts.LogPath != "" {
+		if data, err := os.ReadFile(opts.LogPath); err == nil {
+			files["test.log"] = string(data)
+			logStatus = "included (test.log)"
+		}
+	}
+
+	traceStatus := "not found"
+	if trace := latestTraceSession(opts.TracesDir, opts.SessionID); trace != "" {
+		if data, err := os.ReadFile(trace); err == nil {
+			files["trace-session.json"] = string(data)
+			traceStatus = "included (trace-session.json)"
+		}
+	}
+
+	files["environment.txt"] = formatEnvironment(opts, logStatus, traceStatus)
+
+	path := filepath.Join(dir, fmt.Sprintf("support-bundle-%s.zip", opts.Now.Format("20060102-150405")))
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", fmt.Errorf("failed to create bundle: %w", err)
+	}
+	defer out.Close()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zw := zip.NewWriter(out)
+	for _, name := range names {
+		w, err := zw.Create(name)
+		if err != nil {
+			return "", fmt.Errorf("failed to add %s to bundle: %w", name, err)
+		}
+		if _, err := w.Write([]byte(r.redact(files[name]))); err != nil {
+			return "", fmt.Errorf("failed to write %s to bundle: %w", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return "", fmt.Errorf("failed to finish bundle: %w", err)
+	}
+	return path, nil
+}
+
+// formatEnvironment describes the CLI, platform and toolchain the bundle was created on
+func formatEnvironment(opts BundleOptions, logStatus, traceStatus string) string {
+	sessionID := opts.SessionID
+	if sessionID == "" {
+		sessionID = unknownSession
+	}
+
+	var b strings.Builder
+	b.WriteString("404skill CLI support bundle\n")
+	b.WriteString(fmt.Sprintf("Version:    %s\n", opts.Version))
+	b.WriteString(fmt.Sprintf("Session ID: %s\n", sessionID))
+	b.WriteString(fmt.Sprintf("Platform:   %s/%s\n", runtime.GOOS, runtime.GOARCH))
+	b.WriteString(fmt.Sprintf("Go:         %s\n", runtime.Version()))
+	b.WriteString(fmt.Sprintf("Time:       %s\n", opts.Now.UTC().Format(time.RFC3339)))
+	b.WriteString(fmt.Sprintf("Test log:   %s\n", logStatus))
+	b.WriteString(fmt.Sprintf("Trace:      %s\n", traceStatus))
+	return b.String()
+}
+
+// LatestTestLog returns the most recently written test run log across all projects, or ""
+func LatestTestLog(projectsDir string) string {
+	matches, err := filepath.Glob(filepath.Join(projectsDir, "*", "test-logs", "test-run_*.log"))
+	if err != nil {
+		return ""
+	}
+
+	var latest string
+	var latestTime time.Time
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil {
+			continue
+		}
+		if latest == "" || info.ModTime().After(latestTime) {
+			latest, latestTime = match, info.ModTime()
+		}
+	}
+	return latest
+}
+
+// latestTraceSession picks the newest trace file of the given session, falling back to the newest of any session
+func latestTraceSession(dir, sessionID string) string {
+	if dir == "" {
+		return ""
+	}
+	if sessionID != "" && sessionID != unknownSession {
+		if matches, _ := filepath.Glob(filepath.Join(dir, "session_"+sessionID+"_*.json")); len(matches) > 0 {
+			sort.Strings(matches)
+			return matches[len(matches)-1]
+		}
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "session_*.json"))
+	var latest string
+	var latestTime time.Time
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil {
+			continue
+		}
+		if latest == "" || info.ModTime().After(latestTime) {
+			latest, latestTime = match, info.ModTime()
+		}
+	}
+	return latest
+}
+
+var (
+	// bearerPattern matches Authorization header values
+	bearerPattern = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]+`)
+	// jwtPattern matches JSON web tokens wherever they appear
+	jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// assignmentPattern matches key=value and "key": "value" pairs with sensitive keys
+	assignmentPattern = regexp.MustCompile(`(?i)("?\b(?:password|passwd|pwd|token|access_token|refresh_token|secret|api[_-]?key|authorization)"?\s*[:=]\s*"?)[^"\s,}]+`)
+	// urlCredentialsPattern matches user:password@ in URLs, e.g. proxy settings
+	urlCredentialsPattern = regexp.MustCompile(`(://)[^/@\s:]+:[^/@\s]+@`)
+)
+
+// redactor scrubs credentials and personal paths from bundle contents
+type redactor struct {
+	secrets []string
+	homes   []string
+}
+
+func newRedactor(secrets []string) *redactor {
+	r := &redactor{}
+	for _, s := range secrets {
+		// Very short values would mangle unrelated text and are not worth protecting this way
+		if len(strings.TrimSpace(s)) >= 4 {
+			r.secrets = append(r.secrets, s)
+		}
+	}
+	// Longest first, so a secret containing another is removed whole
+	sort.Slice(r.secrets, func(i, j int) bool { return len(r.secrets[i]) > len(r.secrets[j]) })
+
+	if home, err := homedir.Dir(); err == nil && len(home) > 1 {
+		r.homes = append(r.homes, home)
+		if slashed := filepath.ToSlash(home); slashed != home {
+			r.homes = append(r.homes, slashed)
+		}
+	}
+	return r
+}
+
+func (r *redactor) redact(text string) string {
+	for _, s := range r.secrets {
+		text = strings.ReplaceAll(text, s, redacted)
+	}
+	text = bearerPattern.ReplaceAllString(text, "${1}"+redacted)
+	text = jwtPattern.ReplaceAllString(text, redacted)
+	text = assignmentPattern.ReplaceAllString(text, "${1}"+redacted)
+	text = urlCredentialsPattern.ReplaceAllString(text, "${1}"+redacted+"@")
+	for _, home := range r.homes {
+		text = strings.ReplaceAll(text, home, "~")
+	}
+	return text
+}
@@ -0,0 +1,38 @@
+// Package support formats diagnostic information users can paste into support tickets.
+package support
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"404skill-cli/tracing"
+)
+
+// unknownSession is reported when tracing is disabled and no session ID exists
+const unknownSession = "unavailable"
+
+// SessionID returns the current tracing session ID, if tracing is enabled
+func SessionID() string {
+	if manager := tracing.GetGlobalManager(); manager != nil {
+		return manager.GetSessionID()
+	}
+	return unknownSession
+}
+
+// FormatErrorReport formats an error together with the CLI version and session ID for a support ticket
+func FormatErrorReport(errMsg, version, sessionID string, now time.Time) string {
+	if sessionID == "" {
+		sessionID = unknownSession
+	}
+
+	var b strings.Builder
+	b.WriteString("404skill CLI error report\n")
+	b.WriteString(fmt.Sprintf("Error:      %s\n", strings.TrimSpace(errMsg)))
+	b.WriteString(fmt.Sprintf("Version:    %s\n", version))
+	b.WriteString(fmt.Sprintf("Session ID: %s\n", sessionID))
+	b.WriteString(fmt.Sprintf("Platform:   %s/%s\n", runtime.GOOS, runtime.GOARCH))
+	b.WriteString(fmt.Sprintf("Time:       %s\n", now.UTC().Format(time.RFC3339)))
+	return b.String()
+}
@@ -0,0 +1,35 @@
+package support
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatErrorReport(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	report := FormatErrorReport("API request failed with status 500: boom\n", "1.4.2", "session-abc", now)
+
+	// Assert
+	for _, want := range []string{
+		"Error:      API request failed with status 500: boom",
+		"Version:    1.4.2",
+		"Session ID: session-abc",
+		"Time:       2025-03-01T12:00:00Z",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
+
+func TestFormatErrorReport_MissingSession(t *testing.T) {
+	report := FormatErrorReport("boom", "dev", "", time.Now())
+
+	if !strings.Contains(report, "Session ID: unavailable") {
+		t.Errorf("Expected placeholder session ID, got:\n%s", report)
+	}
+}
@@ -0,0 +1,44 @@
+package testreport
+
+// RunDiff compares a test run with the previous run of the same project
+type RunDiff struct {
+	Regressions []TestResult // passed in the previous run, fail now
+	Fixed       []TestResult // failed in the previous run, pass now
+	regressed   map[string]bool
+}
+
+// testKey identifies a test across runs; names alone may repeat across classes
+func testKey(result TestResult) string {
+	return result.ClassName + "\x00" + result.Name
+}
+
+// Diff compares current with previous. Tests missing from either run are neither regressions
+// nor fixes. A nil previous run yields an empty diff.
+func Diff(previous, current *ParseResult) RunDiff {
+	diff := RunDiff{regressed: make(map[string]bool)}
+	if previous == nil || current == nil {
+		return diff
+	}
+
+	passedBefore := make(map[string]bool, len(previous.Suite.Results))
+	for _, result := range previous.Suite.Results {
+		passedBefore[testKey(result)] = result.Passed
+	}
+	for _, result := range current.Suite.Results {
+		passed, ran := passedBefore[testKey(result)]
+		switch {
+		case !ran:
+		case passed && !result.Passed:
+			diff.Regressions = append(diff.Regressions, result)
+			diff.regressed[testKey(result)] = true
+		case !passed && result.Passed:
+			diff.Fixed = append(diff.Fixed, result)
+		}
+	}
+	return diff
+}
+
+// IsRegression reports whether result passed in the previous run but fails now
+func (d RunDiff) IsRegression(result TestResult) bool {
+	return d.regressed[testKey(result)]
+}
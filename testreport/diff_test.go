@@ -0,0 +1,44 @@
+package testreport
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	// Arrange
+	previous := &ParseResult{Suite: TestSuite{Results: []TestResult{
+		{Name: "test_a", ClassName: "TestTask1", Passed: true},
+		{Name: "test_b", ClassName: "TestTask1", Passed: false},
+		{Name: "test_c", ClassName: "TestTask2", Passed: false},
+		{Name: "test_a", ClassName: "TestTask2", Passed: false},
+	}}}
+	current := &ParseResult{Suite: TestSuite{Results: []TestResult{
+		{Name: "test_a", ClassName: "TestTask1", Passed: false}, // regression
+		{Name: "test_b", ClassName: "TestTask1", Passed: true},  // fixed
+		{Name: "test_c", ClassName: "TestTask2", Passed: false}, // still failing
+		{Name: "test_a", ClassName: "TestTask2", Passed: false}, // same name, other class, still failing
+		{Name: "test_d", ClassName: "TestTask2", Passed: false}, // new
+	}}}
+
+	// Act
+	diff := Diff(previous, current)
+
+	// Assert
+	if len(diff.Regressions) != 1 || diff.Regressions[0].ClassName != "TestTask1" || diff.Regressions[0].Name != "test_a" {
+		t.Errorf("Expected TestTask1.test_a as the only regression, got %+v", diff.Regressions)
+	}
+	if len(diff.Fixed) != 1 || diff.Fixed[0].Name != "test_b" {
+		t.Errorf("Expected test_b as the only fix, got %+v", diff.Fixed)
+	}
+	if !diff.IsRegression(current.Suite.Results[0]) || diff.IsRegression(current.Suite.Results[3]) || diff.IsRegression(current.Suite.Results[4]) {
+		t.Error("Expected IsRegression to match by class and name")
+	}
+}
+
+func TestDiff_NoPreviousRun(t *testing.T) {
+	// Act
+	diff := Diff(nil, &ParseResult{Suite: TestSuite{Results: []TestResult{{Name: "test_a"}}}})
+
+	// Assert
+	if len(diff.Regressions) != 0 || len(diff.Fixed) != 0 || diff.IsRegression(TestResult{Name: "test_a"}) {
+		t.Errorf("Expected an empty diff, got %+v", diff)
+	}
+}
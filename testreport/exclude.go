@@ -0,0 +1,58 @@
+package testreport
+
+import "path"
+
+// IsExcluded reports whether a test matches any exclusion pattern. Patterns are globs
+// matched against the test name, the class name, or "Class.name" (e.g. "Example*", "*.scaffold*").
+func IsExcluded(test TestResult, patterns []string) bool {
+	candidates := []string{test.Name, test.ClassName, test.ClassName + "." + test.Name}
+	for _, pattern := range patterns {
+		for _, candidate := range candidates {
+			if candidate == "" {
+				continue
+			}
+			if matched, err := path.Match(pattern, candidate); err == nil && matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CountedTests returns the failed and passed test names that count toward progress,
+// leaving out tests matching the exclusion patterns. Tests are matched by class and name,
+// so excluding one test keeps same-named tests of other classes.
+func (r *ParseResult) CountedTests(patterns []string) (failed, passed []string) {
+	if len(patterns) == 0 {
+		return r.FailedTests, r.PassedTests
+	}
+
+	failed = make([]string, 0, len(r.FailedTests))
+	passed = make([]string, 0, len(r.PassedTests))
+	if len(r.Suite.Results) == 0 {
+		// Only the names are known, e.g. for results built by hand
+		for _, name := range r.FailedTests {
+			if !IsExcluded(TestResult{Name: name}, patterns) {
+				failed = append(failed, name)
+			}
+		}
+		for _, name := range r.PassedTests {
+			if !IsExcluded(TestResult{Name: name}, patterns) {
+				passed = append(passed, name)
+			}
+		}
+		return failed, passed
+	}
+
+	for _, test := range r.Suite.Results {
+		if IsExcluded(test, patterns) {
+			continue
+		}
+		if test.Passed {
+			passed = append(passed, test.Name)
+		} else {
+			failed = append(failed, test.Name)
+		}
+	}
+	return failed, passed
+}
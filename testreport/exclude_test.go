@@ -0,0 +1,76 @@
+package testreport
+
+import "testing"
+
+func TestIsExcluded(t *testing.T) {
+	tests := []struct {
+		name     string
+		test     TestResult
+		patterns []string
+		expected bool
+	}{
+		{"no patterns", TestResult{Name: "test_a", ClassName: "Task1Test"}, nil, false},
+		{"test name glob", TestResult{Name: "test_scaffold_setup", ClassName: "Task1Test"}, []string{"test_scaffold_*"}, true},
+		{"class name", TestResult{Name: "test_a", ClassName: "ExampleTest"}, []string{"ExampleTest"}, true},
+		{"class and name", TestResult{Name: "test_a", ClassName: "Task1Test"}, []string{"Task1Test.test_a"}, true},
+		{"no match", TestResult{Name: "test_a", ClassName: "Task1Test"}, []string{"Example*"}, false},
+		{"invalid pattern ignored", TestResult{Name: "test_a", ClassName: "Task1Test"}, []string{"[", "test_a"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExcluded(tt.test, tt.patterns); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCountedTests(t *testing.T) {
+	// Arrange
+	result := &ParseResult{
+		PassedTests: []string{"test_a", "test_example"},
+		FailedTests: []string{"test_b"},
+		Suite: TestSuite{Results: []TestResult{
+			{Name: "test_a", ClassName: "Task1Test", Passed: true},
+			{Name: "test_example", ClassName: "ExampleTest", Passed: true},
+			{Name: "test_b", ClassName: "Task1Test"},
+		}},
+	}
+
+	// Act
+	failed, passed := result.CountedTests([]string{"ExampleTest"})
+
+	// Assert
+	if len(passed) != 1 || passed[0] != "test_a" {
+		t.Errorf("Expected only test_a to be counted as passed, got %v", passed)
+	}
+	if len(failed) != 1 || failed[0] != "test_b" {
+		t.Errorf("Expected test_b to be counted as failed, got %v", failed)
+	}
+	if len(result.PassedTests) != 2 {
+		t.Error("Expected the original pass list to be left untouched")
+	}
+}
+
+func TestCountedTests_SameNameInOtherClass(t *testing.T) {
+	// Arrange
+	result := &ParseResult{
+		PassedTests: []string{"test_create", "test_create"},
+		Suite: TestSuite{Results: []TestResult{
+			{Name: "test_create", ClassName: "ExampleTest", Passed: true},
+			{Name: "test_create", ClassName: "Task1Test", Passed: true},
+		}},
+	}
+
+	// Act
+	failed, passed := result.CountedTests([]string{"ExampleTest.test_create"})
+
+	// Assert
+	if len(passed) != 1 || passed[0] != "test_create" {
+		t.Errorf("Expected Task1Test's test_create to still count, got %v", passed)
+	}
+	if len(failed) != 0 {
+		t.Errorf("Expected no failed tests, got %v", failed)
+	}
+}
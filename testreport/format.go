@@ -0,0 +1,125 @@
+package testreport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Format identifies the layout of a test report
+type Format string
+
+const (
+	FormatUnknown Format = ""
+	FormatJUnit   Format = "junit"   // JUnit XML, written by pytest, Maven, jest-junit, ...
+	FormatTAP     Format = "tap"     // Test Anything Protocol, written by node-tap, prove, ...
+	FormatGoJSON  Format = "go-json" // go test -json event stream
+)
+
+// headerPeekSize is how much of a report is read to recognise its format
+const headerPeekSize = 512
+
+var tapPlanPattern = regexp.MustCompile(`^1\.\.\d+`)
+
+// LookupFormat converts a stored format name back to a Format, reporting whether it is known
+func LookupFormat(name string) (Format, bool) {
+	switch f := Format(name); f {
+	case FormatJUnit, FormatTAP, FormatGoJSON:
+		return f, true
+	}
+	return FormatUnknown, false
+}
+
+// DetectFormat recognises a report format from the start of its content
+func DetectFormat(header []byte) Format {
+	trimmed := bytes.TrimSpace(bytes.TrimPrefix(header, []byte("\xef\xbb\xbf")))
+	firstLine := trimmed
+	if i := bytes.IndexByte(trimmed, '\n'); i >= 0 {
+		firstLine = bytes.TrimSpace(trimmed[:i])
+	}
+
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("<?xml")), bytes.HasPrefix(trimmed, []byte("<testsuite")):
+		return FormatJUnit
+	case bytes.HasPrefix(firstLine, []byte("{")) && bytes.Contains(firstLine, []byte(`"Action"`)):
+		return FormatGoJSON
+	case bytes.HasPrefix(firstLine, []byte("TAP version")),
+		tapPlanPattern.Match(firstLine),
+		bytes.HasPrefix(firstLine, []byte("ok ")),
+		bytes.HasPrefix(firstLine, []byte("not ok ")):
+		return FormatTAP
+	}
+	return FormatUnknown
+}
+
+// DetectFileFormat peeks at the header of a report file to recognise its format
+func DetectFileFormat(path string) (Format, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return FormatUnknown, fmt.Errorf("failed to open report: %w", err)
+	}
+	defer file.Close()
+
+	header := make([]byte, headerPeekSize)
+	n, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return FormatUnknown, fmt.Errorf("failed to read report: %w", err)
+	}
+	return DetectFormat(header[:n]), nil
+}
+
+// ReportFile is a recognised report in a reports directory
+type ReportFile struct {
+	Path    string
+	Format  Format
+	ModTime time.Time
+}
+
+// FindReports lists the recognised reports in a directory, newest first. Hidden files and
+// files whose header matches no known format are skipped, whatever their extension.
+func FindReports(dir string) ([]ReportFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reports directory: %w", err)
+	}
+
+	var reports []ReportFile
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		format, err := DetectFileFormat(path)
+		if err != nil || format == FormatUnknown {
+			continue
+		}
+		reports = append(reports, ReportFile{Path: path, Format: format, ModTime: info.ModTime()})
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].ModTime.After(reports[j].ModTime)
+	})
+	return reports, nil
+}
+
+// DetectDirectoryFormat decides the primary report format of a directory: the format of its newest report
+func DetectDirectoryFormat(dir string) (Format, error) {
+	reports, err := FindReports(dir)
+	if err != nil {
+		return FormatUnknown, err
+	}
+	if len(reports) == 0 {
+		return FormatUnknown, fmt.Errorf("no test report found in %s", dir)
+	}
+	return reports[0].Format, nil
+}
@@ -0,0 +1,135 @@
+package testreport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const junitReport = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="tests" tests="2" failures="1" time="0.3" timestamp="2024-01-01T12:00:00">
+    <testcase name="test_health" classname="test_api.TestTask1Health" time="0.1"/>
+    <testcase name="test_create" classname="test_api.TestTask2Create" time="0.2">
+        <failure message="expected 201">AssertionError</failure>
+    </testcase>
+</testsuite>`
+
+const tapReport = `TAP version 13
+# Task 1: health check
+ok 1 - returns 200
+# Task 2: create
+not ok 2 - returns 201
+  ---
+  message: 'expected 201, got 500'
+  ...
+ok 3 - validates input # SKIP not implemented
+1..3
+# tests 3
+# pass 1
+`
+
+const goJSONReport = `{"Time":"2024-01-01T12:00:00Z","Action":"run","Package":"example.com/api","Test":"TestTask1Health"}
+{"Time":"2024-01-01T12:00:00Z","Action":"output","Package":"example.com/api","Test":"TestTask1Health","Output":"=== RUN   TestTask1Health\n"}
+{"Time":"2024-01-01T12:00:00Z","Action":"pass","Package":"example.com/api","Test":"TestTask1Health","Elapsed":0.1}
+{"Time":"2024-01-01T12:00:01Z","Action":"run","Package":"example.com/api","Test":"TestTask2Create"}
+{"Time":"2024-01-01T12:00:01Z","Action":"output","Package":"example.com/api","Test":"TestTask2Create","Output":"    api_test.go:12: expected 201\n"}
+{"Time":"2024-01-01T12:00:01Z","Action":"fail","Package":"example.com/api","Test":"TestTask2Create","Elapsed":0.2}
+{"Time":"2024-01-01T12:00:01Z","Action":"fail","Package":"example.com/api","Elapsed":0.3}
+`
+
+func TestDetectDirectoryFormat_SelectsParserForEachFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		fileName string
+		content  string
+		want     Format
+	}{
+		{name: "JUnit XML", fileName: "results.xml", content: junitReport, want: FormatJUnit},
+		{name: "TAP", fileName: "results.tap", content: tapReport, want: FormatTAP},
+		{name: "TAP without extension", fileName: "results.txt", content: tapReport, want: FormatTAP},
+		{name: "go test JSON", fileName: "results.json", content: goJSONReport, want: FormatGoJSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			dir := t.TempDir()
+			path := filepath.Join(dir, tt.fileName)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write report: %v", err)
+			}
+			if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("not a report\n"), 0644); err != nil {
+				t.Fatalf("Failed to write unrelated file: %v", err)
+			}
+
+			// Act
+			format, err := DetectDirectoryFormat(dir)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if format != tt.want {
+				t.Fatalf("Expected format %q, got %q", tt.want, format)
+			}
+			result, err := NewParser().ParseFileAs(path, format)
+			if err != nil {
+				t.Fatalf("Failed to parse %s report: %v", format, err)
+			}
+			if len(result.PassedTests) != 1 || len(result.FailedTests) != 1 {
+				t.Errorf("Expected 1 passed and 1 failed test, got %v passed, %v failed", result.PassedTests, result.FailedTests)
+			}
+			if completed := result.GroupedResults.CompletedTasks(1.0); len(completed) != 1 || completed[0] != 1 {
+				t.Errorf("Expected task 1 to be complete, got %v", completed)
+			}
+		})
+	}
+}
+
+func TestDetectDirectoryFormat_NoReports(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".write-check-1"), nil, 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+
+	if _, err := DetectDirectoryFormat(dir); err == nil {
+		t.Error("Expected an error for a directory without reports")
+	}
+}
+
+func TestParseTAP_KeepsFailureDiagnostics(t *testing.T) {
+	result, err := NewParser().ParseAs(FormatTAP, strings.NewReader(tapReport))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.Suite.Skipped != 1 {
+		t.Errorf("Expected 1 skipped test, got %d", result.Suite.Skipped)
+	}
+	failed := result.FindTest("Task 2: create", "returns 201")
+	if failed == nil || failed.Failure == nil {
+		t.Fatalf("Expected failed test with its class, got %+v", result.Suite.Results)
+	}
+	if failed.Failure.Message != "expected 201, got 500" {
+		t.Errorf("Expected diagnostic message, got %q", failed.Failure.Message)
+	}
+}
+
+func TestParseGoJSON_KeepsFailureOutput(t *testing.T) {
+	result, err := NewParser().ParseAs(FormatGoJSON, strings.NewReader(goJSONReport))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if result.Suite.Name != "example.com/api" {
+		t.Errorf("Expected the package as suite name, got %q", result.Suite.Name)
+	}
+	failed := result.FindTest("example.com/api.TestTask2Create", "TestTask2Create")
+	if failed == nil || failed.Failure == nil {
+		t.Fatalf("Expected failed test, got %+v", result.Suite.Results)
+	}
+	if failed.Failure.Content != "    api_test.go:12: expected 201\n" {
+		t.Errorf("Expected test output as failure content, got %q", failed.Failure.Content)
+	}
+}
@@ -0,0 +1,129 @@
+package testreport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// goTestEvent is one line of `go test -json` output
+type goTestEvent struct {
+	Time    time.Time `json:"Time"`
+	Action  string    `json:"Action"`
+	Package string    `json:"Package"`
+	Test    string    `json:"Test"`
+	Elapsed float64   `json:"Elapsed"`
+	Output  string    `json:"Output"`
+}
+
+// parseGoJSON reads a `go test -json` event stream. Each test becomes a result classed as
+// "<package>.<top-level test>", like JUnit reports of Go tests, so subtests group under their
+// parent and task numbers in test names are found. A parent with subtests is not counted
+// itself. Output is kept and becomes the failure content when a test fails.
+func (p *Parser) parseGoJSON(reader io.Reader) (*ParseResult, error) {
+	suite := TestSuite{}
+	output := make(map[string]*strings.Builder)
+	packages := make(map[string]bool)
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event goTestEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, fmt.Errorf("failed to decode go test JSON: %w", err)
+		}
+		if suite.Timestamp.IsZero() && !event.Time.IsZero() {
+			suite.Timestamp = event.Time
+		}
+		if event.Test == "" {
+			continue // package-level events
+		}
+
+		key := event.Package + "\x00" + event.Test
+		switch event.Action {
+		case "output":
+			if output[key] == nil {
+				output[key] = &strings.Builder{}
+			}
+			output[key].WriteString(event.Output)
+		case "skip":
+			suite.Skipped++
+		case "pass", "fail":
+			packages[event.Package] = true
+			result := TestResult{
+				Name:      event.Test,
+				ClassName: goTestClassName(event.Package, event.Test),
+				Time:      event.Elapsed,
+				Passed:    event.Action == "pass",
+			}
+			var captured string
+			if b := output[key]; b != nil {
+				captured = b.String()
+				result.Output = &TestOutput{Stdout: captured}
+			}
+			if !result.Passed {
+				result.Failure = &TestFailure{Message: event.Test + " failed", Type: "go test", Content: captured}
+			}
+			suite.Time += event.Elapsed
+			suite.Results = append(suite.Results, result)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read go test JSON: %w", err)
+	}
+
+	suite.Results = withoutParentTests(suite.Results)
+	for _, result := range suite.Results {
+		if !result.Passed {
+			suite.Failures++
+		}
+	}
+
+	if len(suite.Results) == 0 && suite.Skipped == 0 {
+		return nil, fmt.Errorf("no test results found in go test JSON")
+	}
+	suite.Name = "go test"
+	if len(packages) == 1 {
+		for pkg := range packages {
+			suite.Name = pkg
+		}
+	}
+	suite.Tests = len(suite.Results) + suite.Skipped
+	return p.newParseResult(suite), nil
+}
+
+// goTestClassName classes a test by its package and top-level test function
+func goTestClassName(pkg, test string) string {
+	parent, _, _ := strings.Cut(test, "/")
+	if pkg == "" {
+		return parent
+	}
+	return pkg + "." + parent
+}
+
+// withoutParentTests drops tests whose subtests are reported, so each check is counted once
+func withoutParentTests(results []TestResult) []TestResult {
+	parents := make(map[string]bool)
+	for _, result := range results {
+		name := result.Name
+		for i := strings.LastIndex(name, "/"); i >= 0; i = strings.LastIndex(name, "/") {
+			name = name[:i]
+			parents[result.ClassName+"\x00"+name] = true
+		}
+	}
+
+	kept := results[:0]
+	for _, result := range results {
+		if !parents[result.ClassName+"\x00"+result.Name] {
+			kept = append(kept, result)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,75 @@
+package testreport
+
+// FindTest returns the result for the test with the given class and name, or nil if it did not run
+func (r *ParseResult) FindTest(className, name string) *TestResult {
+	for i := range r.Suite.Results {
+		if r.Suite.Results[i].ClassName == className && r.Suite.Results[i].Name == name {
+			return &r.Suite.Results[i]
+		}
+	}
+	return nil
+}
+
+// MergeResult replaces a test's result in place with one from a re-run, updating the pass/fail
+// lists, suite counts and group statistics. It returns false if the test is not part of the results.
+func (r *ParseResult) MergeResult(test TestResult) bool {
+	existing := r.FindTest(test.ClassName, test.Name)
+	if existing == nil {
+		return false
+	}
+	wasPassed := existing.Passed
+	*existing = test
+
+	if wasPassed != test.Passed {
+		if test.Passed {
+			r.FailedTests = removeFirst(r.FailedTests, test.Name)
+			r.PassedTests = append(r.PassedTests, test.Name)
+			r.Suite.Failures--
+		} else {
+			r.PassedTests = removeFirst(r.PassedTests, test.Name)
+			r.FailedTests = append(r.FailedTests, test.Name)
+			r.Suite.Failures++
+		}
+	}
+
+	if r.GroupedResults != nil {
+		r.GroupedResults.merge(test)
+	}
+	return true
+}
+
+// merge replaces the test within its group and recalculates the statistics
+func (g *GroupedTestResults) merge(test TestResult) {
+	g.TotalPassed, g.TotalFailed, g.TotalTime = 0, 0, 0
+
+	for ci := range g.Classes {
+		class := &g.Classes[ci]
+		class.PassedCount, class.FailedCount, class.TotalTime = 0, 0, 0
+
+		for ti := range class.Tests {
+			if class.Tests[ti].ClassName == test.ClassName && class.Tests[ti].Name == test.Name {
+				class.Tests[ti] = test
+			}
+			class.TotalTime += class.Tests[ti].Time
+			if class.Tests[ti].Passed {
+				class.PassedCount++
+			} else {
+				class.FailedCount++
+			}
+		}
+
+		g.TotalPassed += class.PassedCount
+		g.TotalFailed += class.FailedCount
+		g.TotalTime += class.TotalTime
+	}
+}
+
+// removeFirst removes the first occurrence of name, leaving any same-named tests from other classes
+func removeFirst(names []string, name string) []string {
+	for i, n := range names {
+		if n == name {
+			return append(names[:i:i], names[i+1:]...)
+		}
+	}
+	return names
+}
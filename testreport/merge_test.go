@@ -0,0 +1,86 @@
+package testreport
+
+import "testing"
+
+// newMergeFixture builds a result with Task 1 (one pass, one fail) and Task 2 (one pass)
+func newMergeFixture() *ParseResult {
+	tests := []TestResult{
+		{Name: "test_health", ClassName: "test_api.TestTask1", Passed: true, Time: 0.2},
+		{Name: "test_create", ClassName: "test_api.TestTask1", Passed: false, Time: 0.5, Failure: &TestFailure{Message: "expected 201"}},
+		{Name: "test_list", ClassName: "test_api.TestTask2", Passed: true, Time: 0.3},
+	}
+	result := &ParseResult{
+		PassedTests: []string{"test_health", "test_list"},
+		FailedTests: []string{"test_create"},
+		Suite:       TestSuite{Name: "suite", Tests: 3, Failures: 1, Results: tests},
+	}
+	result.GroupedResults = NewParser().groupTestsByTask(tests)
+	return result
+}
+
+func TestParseResult_MergeResult_FailureNowPasses(t *testing.T) {
+	// Arrange
+	result := newMergeFixture()
+	rerun := TestResult{Name: "test_create", ClassName: "test_api.TestTask1", Passed: true, Time: 0.4}
+
+	// Act
+	merged := result.MergeResult(rerun)
+
+	// Assert
+	if !merged {
+		t.Fatal("Expected the re-run test to be merged")
+	}
+	if got := result.FindTest("test_api.TestTask1", "test_create"); got == nil || !got.Passed || got.Failure != nil {
+		t.Errorf("Expected test to be updated in place, got %+v", got)
+	}
+	if len(result.FailedTests) != 0 || len(result.PassedTests) != 3 {
+		t.Errorf("Expected 3 passed and 0 failed, got passed=%v failed=%v", result.PassedTests, result.FailedTests)
+	}
+	if result.Suite.Failures != 0 {
+		t.Errorf("Expected suite failures to drop to 0, got %d", result.Suite.Failures)
+	}
+	task1 := result.GroupedResults.Classes[0]
+	if task1.PassedCount != 2 || task1.FailedCount != 0 {
+		t.Errorf("Expected Task 1 to be 2 passed / 0 failed, got %d / %d", task1.PassedCount, task1.FailedCount)
+	}
+	if task1.Tests[1].Time != 0.4 {
+		t.Errorf("Expected group to hold the re-run result, got time %v", task1.Tests[1].Time)
+	}
+	if result.GroupedResults.TotalFailed != 0 || result.GroupedResults.TotalPassed != 3 {
+		t.Errorf("Expected grouped totals 3 passed / 0 failed, got %d / %d",
+			result.GroupedResults.TotalPassed, result.GroupedResults.TotalFailed)
+	}
+}
+
+func TestParseResult_MergeResult_PassNowFails(t *testing.T) {
+	// Arrange
+	result := newMergeFixture()
+	rerun := TestResult{Name: "test_list", ClassName: "test_api.TestTask2", Passed: false, Failure: &TestFailure{Message: "timeout"}}
+
+	// Act
+	result.MergeResult(rerun)
+
+	// Assert
+	if len(result.FailedTests) != 2 || result.Suite.Failures != 2 {
+		t.Errorf("Expected 2 failures, got %v (suite %d)", result.FailedTests, result.Suite.Failures)
+	}
+	if task2 := result.GroupedResults.Classes[1]; task2.FailedCount != 1 || task2.PassedCount != 0 {
+		t.Errorf("Expected Task 2 to be 0 passed / 1 failed, got %d / %d", task2.PassedCount, task2.FailedCount)
+	}
+}
+
+func TestParseResult_MergeResult_UnknownTest(t *testing.T) {
+	// Arrange
+	result := newMergeFixture()
+
+	// Act
+	merged := result.MergeResult(TestResult{Name: "test_missing", ClassName: "test_api.TestTask1", Passed: true})
+
+	// Assert
+	if merged {
+		t.Error("Expected merging an unknown test to be rejected")
+	}
+	if len(result.Suite.Results) != 3 || len(result.PassedTests) != 2 {
+		t.Error("Expected results to be unchanged")
+	}
+}
@@ -15,21 +15,23 @@ import (
 // XMLTestSuites represents the XML structure of multiple test suites
 type XMLTestSuites struct {
 	XMLName    xml.Name       `xml:"testsuites"`
+	Name       string         `xml:"name,attr"`
 	TestSuites []XMLTestSuite `xml:"testsuite"`
 }
 
 // XMLTestSuite represents the XML structure of a test suite
 type XMLTestSuite struct {
-	XMLName   xml.Name      `xml:"testsuite"`
-	Name      string        `xml:"name,attr"`
-	Tests     int           `xml:"tests,attr"`
-	Skipped   int           `xml:"skipped,attr"`
-	Failures  int           `xml:"failures,attr"`
-	Errors    int           `xml:"errors,attr"`
-	Timestamp string        `xml:"timestamp,attr"`
-	Hostname  string        `xml:"hostname,attr"`
-	Time      float64       `xml:"time,attr"`
-	TestCases []XMLTestCase `xml:"testcase"`
+	XMLName   xml.Name       `xml:"testsuite"`
+	Name      string         `xml:"name,attr"`
+	Tests     int            `xml:"tests,attr"`
+	Skipped   int            `xml:"skipped,attr"`
+	Failures  int            `xml:"failures,attr"`
+	Errors    int            `xml:"errors,attr"`
+	Timestamp string         `xml:"timestamp,attr"`
+	Hostname  string         `xml:"hostname,attr"`
+	Time      float64        `xml:"time,attr"`
+	TestCases []XMLTestCase  `xml:"testcase"`
+	Suites    []XMLTestSuite `xml:"testsuite"` // nested suites, e.g. one per package or class
 }
 
 // XMLTestCase represents the XML structure of a test case
@@ -38,6 +40,7 @@ type XMLTestCase struct {
 	ClassName string      `xml:"classname,attr"`
 	Time      float64     `xml:"time,attr"`
 	Failure   *XMLFailure `xml:"failure,omitempty"`
+	Error     *XMLFailure `xml:"error,omitempty"` // the test crashed rather than failed an assertion
 }
 
 // XMLFailure represents the XML structure of a test failure
@@ -66,8 +69,15 @@ func (p *Parser) Parse(reader io.Reader) (*ParseResult, error) {
 	// First, try to parse as testsuites (multiple test suites)
 	var xmlSuites XMLTestSuites
 	if err := xml.NewDecoder(bytes.NewReader(content)).Decode(&xmlSuites); err == nil && len(xmlSuites.TestSuites) > 0 {
-		// Successfully parsed as testsuites, use the first test suite
-		return p.parseTestSuite(&xmlSuites.TestSuites[0])
+		if len(xmlSuites.TestSuites) == 1 {
+			return p.parseTestSuite(&xmlSuites.TestSuites[0])
+		}
+		// Sibling suites, e.g. one per test file, are merged as the children of one suite
+		name := xmlSuites.Name
+		if name == "" {
+			name = xmlSuites.TestSuites[0].Name
+		}
+		return p.parseTestSuite(&XMLTestSuite{Name: name, Suites: xmlSuites.TestSuites})
 	}
 
 	// If that fails, try to parse as a single testsuite
@@ -79,10 +89,11 @@ func (p *Parser) Parse(reader io.Reader) (*ParseResult, error) {
 	return p.parseTestSuite(&xmlSuite)
 }
 
-// parseTestSuite converts an XMLTestSuite to our domain model
+// parseTestSuite converts an XMLTestSuite to our domain model. Nested suites are flattened
+// into one list of results; their test cases without a class name take the suite's name.
 func (p *Parser) parseTestSuite(xmlSuite *XMLTestSuite) (*ParseResult, error) {
-	// Parse timestamp
-	timestamp, err := time.Parse("2006-01-02T15:04:05", xmlSuite.Timestamp)
+	// Parse timestamp; a parent suite often leaves it to its children
+	timestamp, err := time.Parse("2006-01-02T15:04:05", firstSuiteAttr(xmlSuite, func(s *XMLTestSuite) string { return s.Timestamp }))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse timestamp: %w", err)
 	}
@@ -90,57 +101,136 @@ func (p *Parser) parseTestSuite(xmlSuite *XMLTestSuite) (*ParseResult, error) {
 	// Convert XML suite to our domain model
 	suite := TestSuite{
 		Name:      xmlSuite.Name,
-		Tests:     xmlSuite.Tests,
-		Skipped:   xmlSuite.Skipped,
-		Failures:  xmlSuite.Failures,
-		Errors:    xmlSuite.Errors,
 		Timestamp: timestamp,
-		Hostname:  xmlSuite.Hostname,
-		Time:      xmlSuite.Time,
+		Hostname:  firstSuiteAttr(xmlSuite, func(s *XMLTestSuite) string { return s.Hostname }),
 		Results:   make([]TestResult, 0, len(xmlSuite.TestCases)),
 	}
+	addSuiteResults(&suite, xmlSuite, false)
 
-	// Process test cases
-	passedTests := make([]string, 0)
-	failedTests := make([]string, 0)
+	return p.newParseResult(suite), nil
+}
 
+// addSuiteResults appends the test cases of a suite and its nested suites. A leaf suite's
+// counts come from its attributes; a parent's are the sum of its children, since parents
+// may or may not repeat their children's totals. Errors count as failures: either way the
+// test did not pass.
+func addSuiteResults(suite *TestSuite, xmlSuite *XMLTestSuite, nested bool) {
 	for _, tc := range xmlSuite.TestCases {
+		failure := tc.Failure
+		if failure == nil {
+			failure = tc.Error
+		}
 		result := TestResult{
 			Name:      tc.Name,
 			ClassName: tc.ClassName,
 			Time:      tc.Time,
-			Passed:    tc.Failure == nil,
+			Passed:    failure == nil,
+		}
+		if result.ClassName == "" && nested {
+			result.ClassName = xmlSuite.Name
 		}
 
-		if tc.Failure != nil {
+		if failure != nil {
 			result.Failure = &TestFailure{
-				Message: tc.Failure.Message,
-				Type:    tc.Failure.Type,
-				Content: tc.Failure.Content,
+				Message: failure.Message,
+				Type:    failure.Type,
+				Content: failure.Content,
 			}
-			failedTests = append(failedTests, tc.Name)
-		} else {
-			passedTests = append(passedTests, tc.Name)
 		}
 
 		suite.Results = append(suite.Results, result)
 	}
 
+	if len(xmlSuite.Suites) == 0 {
+		suite.Tests += xmlSuite.Tests
+		suite.Skipped += xmlSuite.Skipped
+		suite.Failures += xmlSuite.Failures + xmlSuite.Errors
+		suite.Errors += xmlSuite.Errors
+		suite.Time += xmlSuite.Time
+		return
+	}
+
+	// Test cases placed directly in a parent suite are counted from the cases themselves
+	suite.Tests += len(xmlSuite.TestCases)
+	for _, tc := range xmlSuite.TestCases {
+		suite.Time += tc.Time
+		if tc.Failure != nil || tc.Error != nil {
+			suite.Failures++
+		}
+		if tc.Error != nil {
+			suite.Errors++
+		}
+	}
+	for i := range xmlSuite.Suites {
+		addSuiteResults(suite, &xmlSuite.Suites[i], true)
+	}
+}
+
+// firstSuiteAttr returns the first non-empty value of an attribute, searching the suite before its nested suites
+func firstSuiteAttr(xmlSuite *XMLTestSuite, attr func(*XMLTestSuite) string) string {
+	if value := attr(xmlSuite); value != "" {
+		return value
+	}
+	for i := range xmlSuite.Suites {
+		if value := firstSuiteAttr(&xmlSuite.Suites[i], attr); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// newParseResult lists the passed and failed tests of a suite and groups them by task
+func (p *Parser) newParseResult(suite TestSuite) *ParseResult {
+	passedTests := make([]string, 0)
+	failedTests := make([]string, 0)
+	for _, result := range suite.Results {
+		if result.Passed {
+			passedTests = append(passedTests, result.Name)
+		} else {
+			failedTests = append(failedTests, result.Name)
+		}
+	}
+
 	return &ParseResult{
 		PassedTests:    passedTests,
 		FailedTests:    failedTests,
 		Suite:          suite,
 		GroupedResults: p.groupTestsByTask(suite.Results),
-	}, nil
+	}
 }
 
-// ParseFile parses a test report from a file
+// ParseAs parses a test report in the given format. Unknown formats are parsed as JUnit XML.
+func (p *Parser) ParseAs(format Format, reader io.Reader) (*ParseResult, error) {
+	switch format {
+	case FormatTAP:
+		return p.parseTAP(reader)
+	case FormatGoJSON:
+		return p.parseGoJSON(reader)
+	default:
+		return p.Parse(reader)
+	}
+}
+
+// ParseFile parses a test report from a file, recognising its format from its header
 func (p *Parser) ParseFile(filename string) (*ParseResult, error) {
 	file, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
-	return p.Parse(bytes.NewReader(file))
+	header := file
+	if len(header) > headerPeekSize {
+		header = header[:headerPeekSize]
+	}
+	return p.ParseAs(DetectFormat(header), bytes.NewReader(file))
+}
+
+// ParseFileAs parses a test report file in a format that is already known
+func (p *Parser) ParseFileAs(filename string, format Format) (*ParseResult, error) {
+	file, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return p.ParseAs(format, bytes.NewReader(file))
 }
 
 // extractTaskNumber extracts task number from various classname formats
@@ -172,19 +262,27 @@ func (p *Parser) extractTaskNumber(className string) int {
 	return -1 // No task number found
 }
 
-// groupTestsByTask groups tests by their task number
+// groupTestsByTask groups tests by their task number. Reports that carry no task
+// numbers at all are grouped by class name instead of one flat "Uncategorized" group.
 func (p *Parser) groupTestsByTask(results []TestResult) *GroupedTestResults {
 	taskMap := make(map[int][]TestResult)
+	foundTaskNumber := false
 
 	// Group tests by task number
 	for _, result := range results {
 		taskNum := p.extractTaskNumber(result.ClassName)
 		if taskNum == -1 {
 			taskNum = 0 // Put tests without task numbers in "Task 0"
+		} else {
+			foundTaskNumber = true
 		}
 		taskMap[taskNum] = append(taskMap[taskNum], result)
 	}
 
+	if !foundTaskNumber && len(results) > 0 {
+		return p.groupTestsByClass(results)
+	}
+
 	// Convert to TestClass structs and sort by task number
 	var classes []TestClass
 	var taskNumbers []int
@@ -193,52 +291,69 @@ func (p *Parser) groupTestsByTask(results []TestResult) *GroupedTestResults {
 	}
 	sort.Ints(taskNumbers)
 
-	totalTests := 0
-	totalPassed := 0
-	totalFailed := 0
-	totalTime := 0.0
-
 	for _, taskNum := range taskNumbers {
-		tests := taskMap[taskNum]
-
-		var taskName string
-		var displayName string
 		if taskNum == 0 {
-			taskName = "Uncategorized"
-			displayName = "Uncategorized Tests"
+			classes = append(classes, newTestClass("Uncategorized", "Uncategorized Tests", 0, taskMap[taskNum]))
 		} else {
-			taskName = fmt.Sprintf("Task%d", taskNum)
-			displayName = fmt.Sprintf("Task %d", taskNum)
+			classes = append(classes, newTestClass(fmt.Sprintf("Task%d", taskNum), fmt.Sprintf("Task %d", taskNum), taskNum, taskMap[taskNum]))
 		}
+	}
+
+	return newGroupedResults(classes)
+}
 
-		class := TestClass{
-			Name:        taskName,
-			DisplayName: displayName,
-			Tests:       tests,
+// groupTestsByClass groups tests by class name, in alphabetical order. Used for projects
+// that don't follow the TestTask naming convention; none of the groups count as a task.
+func (p *Parser) groupTestsByClass(results []TestResult) *GroupedTestResults {
+	classMap := make(map[string][]TestResult)
+	var classNames []string
+	for _, result := range results {
+		if _, ok := classMap[result.ClassName]; !ok {
+			classNames = append(classNames, result.ClassName)
 		}
+		classMap[result.ClassName] = append(classMap[result.ClassName], result)
+	}
+	sort.Strings(classNames)
 
-		// Calculate statistics
-		for _, test := range tests {
-			class.TotalTime += test.Time
-			if test.Passed {
-				class.PassedCount++
-				totalPassed++
-			} else {
-				class.FailedCount++
-				totalFailed++
-			}
-			totalTests++
+	classes := make([]TestClass, 0, len(classNames))
+	for _, className := range classNames {
+		name, displayName := className, className
+		if className == "" {
+			name, displayName = "Uncategorized", "Uncategorized Tests"
 		}
-		totalTime += class.TotalTime
+		classes = append(classes, newTestClass(name, displayName, 0, classMap[className]))
+	}
+
+	return newGroupedResults(classes)
+}
 
-		classes = append(classes, class)
+// newTestClass builds a group and calculates its statistics
+func newTestClass(name, displayName string, taskNum int, tests []TestResult) TestClass {
+	class := TestClass{
+		Name:        name,
+		DisplayName: displayName,
+		TaskNumber:  taskNum,
+		Tests:       tests,
 	}
+	for _, test := range tests {
+		class.TotalTime += test.Time
+		if test.Passed {
+			class.PassedCount++
+		} else {
+			class.FailedCount++
+		}
+	}
+	return class
+}
 
-	return &GroupedTestResults{
-		Classes:     classes,
-		TotalTests:  totalTests,
-		TotalPassed: totalPassed,
-		TotalFailed: totalFailed,
-		TotalTime:   totalTime,
+// newGroupedResults totals the statistics of the given groups
+func newGroupedResults(classes []TestClass) *GroupedTestResults {
+	grouped := &GroupedTestResults{Classes: classes}
+	for _, class := range classes {
+		grouped.TotalTests += class.PassedCount + class.FailedCount
+		grouped.TotalPassed += class.PassedCount
+		grouped.TotalFailed += class.FailedCount
+		grouped.TotalTime += class.TotalTime
 	}
+	return grouped
 }
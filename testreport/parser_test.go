@@ -79,6 +79,117 @@ func TestParser_Parse(t *testing.T) {
 	}
 }
 
+func TestParser_Parse_NestedSuites(t *testing.T) {
+	xmlContent := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="4" failures="1" time="9.9">
+    <testsuite name="api.TestTask1Health" tests="2" failures="0" timestamp="2024-03-20T10:00:00" hostname="ci" time="0.5">
+      <testcase name="returns ok" time="0.2"/>
+      <testcase name="reports version" time="0.3"/>
+    </testsuite>
+    <testsuite name="api.tasks">
+      <testsuite name="api.TestTask2Entries" tests="1" failures="1" time="0.4">
+        <testcase name="creates entry" classname="api.TestTask2Entries" time="0.4">
+          <failure message="expected 201" type="AssertionError">got 500</failure>
+        </testcase>
+      </testsuite>
+      <testcase name="lists entries" classname="api.TestTask2Entries" time="0.1"/>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+
+	parser := NewParser()
+	result, err := parser.Parse(strings.NewReader(xmlContent))
+	if err != nil {
+		t.Fatalf("Failed to parse XML: %v", err)
+	}
+
+	// All test cases are captured, with unnamed classes taken from their suite
+	if len(result.Suite.Results) != 4 {
+		t.Fatalf("Expected 4 test results, got %d", len(result.Suite.Results))
+	}
+	classes := map[string]string{}
+	for _, r := range result.Suite.Results {
+		classes[r.Name] = r.ClassName
+	}
+	if classes["returns ok"] != "api.TestTask1Health" || classes["lists entries"] != "api.TestTask2Entries" {
+		t.Errorf("Expected class names to be preserved, got %v", classes)
+	}
+
+	// Counts are summed across the hierarchy rather than taken from the parent
+	if result.Suite.Tests != 4 || result.Suite.Failures != 1 {
+		t.Errorf("Expected 4 tests and 1 failure, got %d and %d", result.Suite.Tests, result.Suite.Failures)
+	}
+	if result.Suite.Time < 0.99 || result.Suite.Time > 1.01 {
+		t.Errorf("Expected time 1.0, got %f", result.Suite.Time)
+	}
+	if result.Suite.Name != "api" || result.Suite.Hostname != "ci" || result.Suite.Timestamp.IsZero() {
+		t.Errorf("Expected suite details from the hierarchy, got %q on %q at %v", result.Suite.Name, result.Suite.Hostname, result.Suite.Timestamp)
+	}
+
+	// Tests group by task across nesting levels
+	grouped := result.GroupedResults
+	if len(grouped.Classes) != 2 {
+		t.Fatalf("Expected 2 task groups, got %d", len(grouped.Classes))
+	}
+	if grouped.Classes[0].TaskNumber != 1 || grouped.Classes[0].PassedCount != 2 {
+		t.Errorf("Expected Task 1 with 2 passing tests, got %+v", grouped.Classes[0])
+	}
+	if grouped.Classes[1].TaskNumber != 2 || grouped.Classes[1].PassedCount != 1 || grouped.Classes[1].FailedCount != 1 {
+		t.Errorf("Expected Task 2 with 1 pass and 1 failure, got %+v", grouped.Classes[1])
+	}
+}
+
+func TestParser_Parse_SiblingSuites(t *testing.T) {
+	xmlContent := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="jest tests">
+  <testsuite name="health.test.js" tests="1" failures="0" errors="0" timestamp="2024-03-20T10:00:00" time="0.2">
+    <testcase name="returns ok" classname="Task 1: Health" time="0.2"/>
+  </testsuite>
+  <testsuite name="entries.test.js" tests="2" failures="1" errors="0" time="0.5">
+    <testcase name="creates entry" classname="Task 2: Entries" time="0.3">
+      <failure message="expected 201">got 500</failure>
+    </testcase>
+    <testcase name="lists entries" classname="Task 2: Entries" time="0.2"/>
+  </testsuite>
+  <testsuite name="search.test.js" tests="1" failures="0" errors="1" time="0.1">
+    <testcase name="finds entry" classname="Task 3: Search" time="0.1">
+      <error message="TypeError: undefined is not a function" type="TypeError">at search.js:4</error>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	parser := NewParser()
+	result, err := parser.Parse(strings.NewReader(xmlContent))
+	if err != nil {
+		t.Fatalf("Failed to parse XML: %v", err)
+	}
+
+	// Every sibling suite is read, not only the first
+	if len(result.Suite.Results) != 4 {
+		t.Fatalf("Expected 4 test results, got %d", len(result.Suite.Results))
+	}
+	if result.Suite.Name != "jest tests" {
+		t.Errorf("Expected the suite to be named after <testsuites>, got %q", result.Suite.Name)
+	}
+
+	// An errored test is a failure, in the totals and in the results
+	if result.Suite.Tests != 4 || result.Suite.Failures != 2 {
+		t.Errorf("Expected 4 tests and 2 failures, got %d and %d", result.Suite.Tests, result.Suite.Failures)
+	}
+	if len(result.FailedTests) != 2 {
+		t.Errorf("Expected 2 failed tests, got %v", result.FailedTests)
+	}
+	for _, r := range result.Suite.Results {
+		if r.Name == "finds entry" && (r.Passed || r.Failure == nil || r.Failure.Type != "TypeError") {
+			t.Errorf("Expected the errored test to fail with its error, got %+v", r)
+		}
+	}
+	if len(result.GroupedResults.Classes) != 3 {
+		t.Errorf("Expected 3 task groups, got %d", len(result.GroupedResults.Classes))
+	}
+}
+
 func TestParser_Parse_InvalidXML(t *testing.T) {
 	parser := NewParser()
 	_, err := parser.Parse(strings.NewReader("invalid xml"))
@@ -234,6 +345,9 @@ func TestParser_GroupTestsByTask(t *testing.T) {
 		if len(class.Tests) != expectedTestCounts[i] {
 			t.Errorf("Group %d: expected %d tests, got %d", i, expectedTestCounts[i], len(class.Tests))
 		}
+		if class.TaskNumber != i {
+			t.Errorf("Group %d: expected task number %d, got %d", i, i, class.TaskNumber)
+		}
 	}
 
 	// Check specific group contents
@@ -253,3 +367,98 @@ func TestParser_GroupTestsByTask(t *testing.T) {
 		t.Errorf("Task2: expected 1 failed test, got %d", task2.FailedCount)
 	}
 }
+
+func TestParser_GroupTestsByClass_WhenNoTaskNumbers(t *testing.T) {
+	xmlContent := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="Test Suite" tests="4" failures="1" errors="0" time="1.0" timestamp="2024-03-20T10:00:00" hostname="localhost">
+  <testcase name="test_create" classname="tests.test_users.UserTests" time="0.2"/>
+  <testcase name="test_login" classname="tests.test_auth.AuthTests" time="0.3">
+    <failure message="401">Unauthorized</failure>
+  </testcase>
+  <testcase name="test_delete" classname="tests.test_users.UserTests" time="0.4"/>
+  <testcase name="test_logout" classname="tests.test_auth.AuthTests" time="0.1"/>
+</testsuite>`
+
+	parser := NewParser()
+	result, err := parser.Parse(strings.NewReader(xmlContent))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	grouped := result.GroupedResults
+	if grouped == nil {
+		t.Fatal("GroupedResults should not be nil")
+	}
+
+	// Should have one group per class, sorted by name, instead of a single Uncategorized group
+	expectedNames := []string{"tests.test_auth.AuthTests", "tests.test_users.UserTests"}
+	if len(grouped.Classes) != len(expectedNames) {
+		t.Fatalf("Expected %d groups, got %d", len(expectedNames), len(grouped.Classes))
+	}
+	for i, class := range grouped.Classes {
+		if class.Name != expectedNames[i] || class.DisplayName != expectedNames[i] {
+			t.Errorf("Group %d: expected %q, got name %q display %q", i, expectedNames[i], class.Name, class.DisplayName)
+		}
+		if len(class.Tests) != 2 {
+			t.Errorf("Group %d: expected 2 tests, got %d", i, len(class.Tests))
+		}
+		if class.TaskNumber != 0 {
+			t.Errorf("Group %d: class groups should not count as tasks, got task number %d", i, class.TaskNumber)
+		}
+	}
+
+	if grouped.Classes[0].FailedCount != 1 || grouped.Classes[0].PassedCount != 1 {
+		t.Errorf("AuthTests: expected 1 passed and 1 failed, got %d and %d", grouped.Classes[0].PassedCount, grouped.Classes[0].FailedCount)
+	}
+	if grouped.TotalTests != 4 || grouped.TotalFailed != 1 {
+		t.Errorf("Expected 4 tests with 1 failure, got %d with %d", grouped.TotalTests, grouped.TotalFailed)
+	}
+	if completed := grouped.CompletedTasks(DefaultCompletionThreshold); len(completed) != 0 {
+		t.Errorf("Expected no completed tasks, got %v", completed)
+	}
+}
+
+func TestGroupedTestResults_CompletedTasks(t *testing.T) {
+	// Arrange
+	grouped := &GroupedTestResults{
+		Classes: []TestClass{
+			{Name: "Uncategorized", TaskNumber: 0, PassedCount: 3},
+			{Name: "Task1", TaskNumber: 1, PassedCount: 2},
+			{Name: "Task2", TaskNumber: 2, PassedCount: 3, FailedCount: 1},
+			{Name: "Task3", TaskNumber: 3},
+		},
+	}
+
+	// Act
+	allPassing := grouped.CompletedTasks(DefaultCompletionThreshold)
+	relaxed := grouped.CompletedTasks(0.75)
+
+	// Assert
+	if len(allPassing) != 1 || allPassing[0] != 1 {
+		t.Errorf("Expected only fully passing Task 1 to be complete, got %v", allPassing)
+	}
+	if len(relaxed) != 2 || relaxed[0] != 1 || relaxed[1] != 2 {
+		t.Errorf("Expected Tasks 1 and 2 to be complete at 75%%, got %v", relaxed)
+	}
+}
+
+func TestTestClass_IsComplete(t *testing.T) {
+	tests := []struct {
+		name     string
+		class    TestClass
+		expected bool
+	}{
+		{"all passing", TestClass{PassedCount: 4}, true},
+		{"partially passing", TestClass{PassedCount: 3, FailedCount: 1}, false},
+		{"all failing", TestClass{FailedCount: 2}, false},
+		{"no tests", TestClass{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.class.IsComplete(DefaultCompletionThreshold); got != tt.expected {
+				t.Errorf("Expected IsComplete=%v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package testreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// LastRunFileName is the file a project's latest test results are saved to
+const LastRunFileName = "last.json"
+
+// StoredRun is a project's latest test run as saved on disk
+type StoredRun struct {
+	ProjectID   string       `json:"project_id"`
+	ProjectName string       `json:"project_name"`
+	Language    string       `json:"language"`
+	RanAt       time.Time    `json:"ran_at"`
+	Result      *ParseResult `json:"result"`
+	SourceHash  string       `json:"source_hash,omitempty"` // hash of the sources the run tested, empty when unknown
+}
+
+// SaveLastRun writes a project's latest results to <resultsDir>/<project ID>/last.json
+func SaveLastRun(resultsDir string, run StoredRun) error {
+	if run.ProjectID == "" || run.ProjectID != filepath.Base(run.ProjectID) || run.ProjectID == ".." {
+		return fmt.Errorf("invalid project ID %q", run.ProjectID)
+	}
+
+	dir := filepath.Join(resultsDir, run.ProjectID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create results directory: %w", err)
+	}
+	data, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to encode results: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, LastRunFileName), data, 0644); err != nil {
+		return fmt.Errorf("failed to save results: %w", err)
+	}
+	return nil
+}
+
+// LoadLastRun reads a project's latest saved results, returning nil when it has none
+func LoadLastRun(resultsDir, projectID string) (*StoredRun, error) {
+	if projectID == "" || projectID != filepath.Base(projectID) || projectID == ".." {
+		return nil, fmt.Errorf("invalid project ID %q", projectID)
+	}
+
+	data, err := os.ReadFile(filepath.Join(resultsDir, projectID, LastRunFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read saved results: %w", err)
+	}
+	var run StoredRun
+	if err := json.Unmarshal(data, &run); err != nil {
+		return nil, fmt.Errorf("failed to decode saved results: %w", err)
+	}
+	return &run, nil
+}
+
+// LoadLastRuns reads the latest results of every project saved under resultsDir, ordered by
+// project name. Unreadable files are skipped so one corrupt project doesn't hide the others.
+func LoadLastRuns(resultsDir string) ([]StoredRun, error) {
+	entries, err := os.ReadDir(resultsDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results directory: %w", err)
+	}
+
+	var runs []StoredRun
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(resultsDir, entry.Name(), LastRunFileName))
+		if err != nil {
+			continue
+		}
+		var run StoredRun
+		if err := json.Unmarshal(data, &run); err != nil || run.Result == nil {
+			continue
+		}
+		runs = append(runs, run)
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		if runs[i].ProjectName != runs[j].ProjectName {
+			return runs[i].ProjectName < runs[j].ProjectName
+		}
+		return runs[i].Language < runs[j].Language
+	})
+	return runs, nil
+}
@@ -0,0 +1,130 @@
+package testreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// tapResultPattern matches "ok 1 - description # directive" and "not ok 2 description"
+var tapResultPattern = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(.*))?$`)
+
+// parseTAP reads a Test Anything Protocol report. Top-level results become tests; the most
+// recent "# name" comment (tape's test headings, node-tap's "# Subtest: name") becomes their
+// class. SKIP results are counted but not listed, TODO failures count as passes.
+func (p *Parser) parseTAP(reader io.Reader) (*ParseResult, error) {
+	suite := TestSuite{Name: "TAP report"}
+	className := ""
+	var current *TestResult
+	var diagnostics []string
+	inDiagnostics := false
+
+	finish := func() {
+		if current == nil {
+			return
+		}
+		if current.Failure != nil && len(diagnostics) > 0 {
+			current.Failure.Content = strings.Join(diagnostics, "\n")
+			if message := tapDiagnosticMessage(diagnostics); message != "" {
+				current.Failure.Message = message
+			}
+		}
+		suite.Results = append(suite.Results, *current)
+		current = nil
+		diagnostics = nil
+	}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		// YAML diagnostics follow the result they describe, indented between "---" and "..."
+		if inDiagnostics {
+			if trimmed == "..." {
+				inDiagnostics = false
+			} else {
+				diagnostics = append(diagnostics, trimmed)
+			}
+			continue
+		}
+		if trimmed == "---" && current != nil {
+			inDiagnostics = true
+			continue
+		}
+
+		// Nested subtest output is indented; only its top-level summary line is counted
+		if line != strings.TrimLeft(line, " \t") {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			comment := strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))
+			if name, ok := strings.CutPrefix(comment, "Subtest:"); ok {
+				comment = strings.TrimSpace(name)
+			}
+			if comment != "" && !tapSummaryComment(comment) {
+				className = comment
+			}
+		case tapResultPattern.MatchString(trimmed):
+			finish()
+			matches := tapResultPattern.FindStringSubmatch(trimmed)
+			passed := matches[1] == "ok"
+			name := matches[3]
+			if name == "" {
+				name = "test " + matches[2]
+			}
+			directive := strings.ToUpper(matches[4])
+			if strings.HasPrefix(directive, "SKIP") {
+				suite.Skipped++
+				continue
+			}
+			if strings.HasPrefix(directive, "TODO") {
+				passed = true
+			}
+
+			current = &TestResult{Name: name, ClassName: className, Passed: passed}
+			if !passed {
+				current.Failure = &TestFailure{Message: "not ok", Type: "TAP"}
+				suite.Failures++
+			}
+		case strings.HasPrefix(trimmed, "Bail out!"):
+			finish()
+			return nil, fmt.Errorf("TAP report bailed out: %s", strings.TrimSpace(strings.TrimPrefix(trimmed, "Bail out!")))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read TAP content: %w", err)
+	}
+	finish()
+
+	if len(suite.Results) == 0 && suite.Skipped == 0 {
+		return nil, fmt.Errorf("no test results found in TAP report")
+	}
+	suite.Tests = len(suite.Results) + suite.Skipped
+	return p.newParseResult(suite), nil
+}
+
+// tapSummaryComment reports whether a comment is one of the trailing totals, e.g. "# pass 3"
+func tapSummaryComment(comment string) bool {
+	for _, prefix := range []string{"tests ", "pass ", "fail ", "skip ", "todo ", "ok", "time=", "duration_ms", "cancelled ", "suites "} {
+		if strings.HasPrefix(comment, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// tapDiagnosticMessage extracts the "message:" entry of a YAML diagnostic block
+func tapDiagnosticMessage(diagnostics []string) string {
+	for _, line := range diagnostics {
+		if message, ok := strings.CutPrefix(line, "message:"); ok {
+			return strings.Trim(strings.TrimSpace(message), `"'`)
+		}
+	}
+	return ""
+}
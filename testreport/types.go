@@ -50,6 +50,7 @@ type ParseResult struct {
 type TestClass struct {
 	Name        string       // e.g., "Task1", "Task2"
 	DisplayName string       // e.g., "Task 1", "Task 2"
+	TaskNumber  int          // e.g., 1, 2; 0 for uncategorized tests
 	Tests       []TestResult // Tests in this group
 	PassedCount int          // Number of passed tests
 	FailedCount int          // Number of failed tests
@@ -64,3 +65,39 @@ type GroupedTestResults struct {
 	TotalFailed int         // Total failed tests
 	TotalTime   float64     // Total execution time
 }
+
+// DefaultCompletionThreshold is the pass rate at which a task counts as complete (all tests passing)
+const DefaultCompletionThreshold = 1.0
+
+// PassRate returns the fraction of tests in the group that passed
+func (c TestClass) PassRate() float64 {
+	total := c.PassedCount + c.FailedCount
+	if total == 0 {
+		return 0
+	}
+	return float64(c.PassedCount) / float64(total)
+}
+
+// IsComplete reports whether the group's pass rate meets the threshold
+func (c TestClass) IsComplete(threshold float64) bool {
+	if c.PassedCount+c.FailedCount == 0 {
+		return false
+	}
+	return c.PassRate() >= threshold
+}
+
+// CompletedTasks returns the numbers of tasks whose groups meet the threshold.
+// Uncategorized tests are never counted as a task.
+func (g *GroupedTestResults) CompletedTasks(threshold float64) []int {
+	if g == nil {
+		return nil
+	}
+
+	var completed []int
+	for _, class := range g.Classes {
+		if class.TaskNumber > 0 && class.IsComplete(threshold) {
+			completed = append(completed, class.TaskNumber)
+		}
+	}
+	return completed
+}
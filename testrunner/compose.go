@@ -0,0 +1,86 @@
+package testrunner
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Invocations for the docker compose v2 plugin and the legacy v1 standalone binary
+var (
+	composePluginCommand     = []string{"docker", "compose"}
+	composeStandaloneCommand = []string{"docker-compose"}
+)
+
+// selectComposeCommand picks the compose invocation given which forms are available,
+// preferring the v2 plugin and falling back to the v1 standalone binary
+func selectComposeCommand(pluginAvailable, standaloneAvailable bool) ([]string, error) {
+	if pluginAvailable {
+		return composePluginCommand, nil
+	}
+	if standaloneAvailable {
+		return composeStandaloneCommand, nil
+	}
+	return nil, fmt.Errorf("Docker Compose not found: 'docker compose' is not available and no 'docker-compose' binary is installed. Please upgrade Docker Desktop or install the Docker Compose plugin")
+}
+
+// detectComposeCommand checks which docker compose form is installed on this machine
+func detectComposeCommand() ([]string, error) {
+	pluginAvailable := exec.Command("docker", "compose", "version").Run() == nil
+
+	standaloneAvailable := false
+	if !pluginAvailable {
+		_, err := exec.LookPath("docker-compose")
+		standaloneAvailable = err == nil
+	}
+
+	return selectComposeCommand(pluginAvailable, standaloneAvailable)
+}
+
+// composeArgs returns the docker compose arguments, rebuilding the image only when build is set.
+// A non-empty service limits the run to that service and its dependencies, and the run's exit
+// code becomes the service's, so a dependency stopping is not mistaken for the test result.
+func composeArgs(build bool, service string) []string {
+	args := []string{"-f", composeFileName, "up"}
+	if build {
+		args = append(args, "--build")
+	}
+	args = append(args, "--abort-on-container-exit")
+	if service != "" {
+		args = append(args, "--exit-code-from", service, service)
+	}
+	return args
+}
+
+// newComposeCmd creates a command for the given compose invocation and arguments
+func newComposeCmd(compose []string, args ...string) *exec.Cmd {
+	return exec.Command(compose[0], append(append([]string{}, compose[1:]...), args...)...)
+}
+
+// composeImageExists reports whether the compose project already has its images built
+func composeImageExists(compose []string, projectDir string) bool {
+	cmd := newComposeCmd(compose, "-f", composeFileName, "images", "-q")
+	cmd.Dir = projectDir
+	output, err := cmd.Output()
+	return err == nil && strings.TrimSpace(string(output)) != ""
+}
+
+// composeCommand builds the docker compose command used to run the project's tests
+// A non-empty selector limits the run to the matching test, a non-empty service to that service.
+func (r *DefaultTestRunner) composeCommand(compose []string, projectDir string, build bool, service, selector, language string) *exec.Cmd {
+	cmd := newComposeCmd(compose, composeArgs(build, service)...)
+	cmd.Dir = projectDir
+	cmd.Env = os.Environ()
+	if r.config.BuildKit {
+		cmd.Env = append(cmd.Env, "DOCKER_BUILDKIT=1", "COMPOSE_DOCKER_CLI_BUILD=1")
+	}
+	if selector != "" {
+		cmd.Env = append(cmd.Env, TestSelectorEnv+"="+selector)
+	}
+	if r.config.DebugTests {
+		// Plain build progress keeps every build step's output instead of collapsing it
+		cmd.Env = append(cmd.Env, DebugArgsEnv+"="+strings.Join(DebugFlags(language), " "), "BUILDKIT_PROGRESS=plain")
+	}
+	return cmd
+}
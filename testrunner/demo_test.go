@@ -0,0 +1,28 @@
+package testrunner
+
+import "testing"
+
+func TestDemoRunner_ReturnsCannedResults(t *testing.T) {
+	// Arrange
+	runner := &DemoRunner{}
+	var output []string
+
+	// Act
+	result, err := runner.RunTests(Project{ID: "demo", Name: `Journal "API"`}, func(line string) {
+		output = append(output, line)
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected canned report to parse, got: %v", err)
+	}
+	if len(result.PassedTests) != 4 || len(result.FailedTests) != 2 {
+		t.Errorf("Expected 4 passed and 2 failed, got %d and %d", len(result.PassedTests), len(result.FailedTests))
+	}
+	if result.Suite.Name != `Journal "API"` {
+		t.Errorf("Expected suite named after the project, got %q", result.Suite.Name)
+	}
+	if len(output) == 0 {
+		t.Error("Expected progress output")
+	}
+}
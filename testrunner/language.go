@@ -0,0 +1,48 @@
+package testrunner
+
+import "strings"
+
+// Language families the runner knows per-language defaults for
+const (
+	languageGo     = "go"
+	languageJS     = "javascript"
+	languageJava   = "java"
+	languageCSharp = "csharp"
+	languagePython = "python"
+)
+
+// languageFamily maps the language names used by projects onto a known family, or "" if unknown
+func languageFamily(language string) string {
+	switch strings.ToLower(strings.TrimSpace(language)) {
+	case "go", "golang":
+		return languageGo
+	case "javascript", "typescript", "js", "ts", "node", "nodejs":
+		return languageJS
+	case "java", "kotlin":
+		return languageJava
+	case "c#", "csharp", ".net", "dotnet":
+		return languageCSharp
+	case "python", "py":
+		return languagePython
+	default:
+		return ""
+	}
+}
+
+// DebugArgsEnv carries extra verbosity flags to the test container in debug mode. Compose files
+// opt in by appending it to the test command, e.g. `pytest $TEST_EXTRA_ARGS`.
+const DebugArgsEnv = "TEST_EXTRA_ARGS"
+
+// debugFlags holds the verbosity flags for each language's usual test command
+var debugFlags = map[string][]string{
+	languageGo:     {"-v"},                      // go test
+	languageJS:     {"--verbose"},               // jest
+	languageJava:   {"--info", "--stacktrace"},  // gradle
+	languageCSharp: {"--verbosity", "detailed"}, // dotnet test
+	languagePython: {"-vv", "--tb=long", "-rA"}, // pytest
+}
+
+// DebugFlags returns the extra flags that make the language's test command print maximum diagnostics
+func DebugFlags(language string) []string {
+	return debugFlags[languageFamily(language)]
+}
@@ -2,30 +2,167 @@ package testrunner
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"404skill-cli/homedir"
 	"404skill-cli/testreport"
 )
 
+// composeFileName is the compose file every project ships for running its tests
+const composeFileName = "docker-compose.test.yml"
+
+// RunnerConfig holds configuration for the test runner
+type RunnerConfig struct {
+	BuildKit         bool          // build test images with BuildKit for better layer caching
+	FastRerun        bool          // reuse the existing test image instead of rebuilding it
+	ProjectsDir      string        // where projects are downloaded; defaults to ~/404skill_projects
+	DebugTests       bool          // pass per-language verbosity flags to the test command for CI debugging
+	RunTimeout       time.Duration // stop a compose run that takes longer; 0 means no limit
+	ResourceStats    bool          // sample the containers' memory and CPU with docker stats during a run
+	SourceHashIgnore []string      // extra glob patterns left out of the source hash, on top of build output
+}
+
+// DefaultRunnerConfig returns the default runner configuration
+func DefaultRunnerConfig() RunnerConfig {
+	return RunnerConfig{
+		BuildKit:   true,
+		RunTimeout: DefaultRunTimeout,
+	}
+}
+
+// ErrAlreadyRunning is returned when a project's tests are started while a run of them is still going;
+// two compose runs against one project would overwrite each other's reports
+var ErrAlreadyRunning = errors.New("tests are already running for this project")
+
+// ErrNoReport is returned when a run wrote no recognisable test report
+var ErrNoReport = errors.New("no test report found")
+
+// ReportParseError is returned when a run wrote a test report that could not be parsed, e.g. XML
+// cut short because the container was killed. The report is kept at Path for inspection.
+type ReportParseError struct {
+	Path   string
+	Format testreport.Format
+	Err    error
+}
+
+func (e *ReportParseError) Error() string {
+	return fmt.Sprintf("test report was produced but couldn't be parsed (%s): %v", e.Path, e.Err)
+}
+
+func (e *ReportParseError) Unwrap() error {
+	return e.Err
+}
+
 // DefaultTestRunner implements TestRunner using docker-compose
 type DefaultTestRunner struct {
-	logFilter *LogFilter
+	logFilter     *LogFilter
+	config        RunnerConfig
+	projectsDir   string                                         // overrides ~/404skill_projects when set
+	dockerCheck   func() error                                   // verifies the container engine is reachable
+	imageCheck    func(compose []string, projectDir string) bool // reports whether the test image was already built
+	composeDetect func() ([]string, error)                       // resolves the docker compose invocation
+	formatCache   ReportFormatCache                              // remembers each project's report format, optional
+	services      TestServiceSource                              // names each project's test service, optional
+
+	// statsSample reads the resource use of the project's running containers
+	statsSample func(compose []string, projectDir string) ([]ContainerStats, error)
+
+	mu     sync.Mutex
+	active map[string]bool          // IDs of projects with a run in progress
+	usage  map[string]ResourceUsage // project ID -> usage of its last run, when stats are enabled
 }
 
 // NewDefaultTestRunner creates a new test runner
 func NewDefaultTestRunner() *DefaultTestRunner {
+	return NewDefaultTestRunnerWithConfig(DefaultRunnerConfig())
+}
+
+// NewDefaultTestRunnerWithConfig creates a new test runner with the given configuration
+func NewDefaultTestRunnerWithConfig(config RunnerConfig) *DefaultTestRunner {
 	return &DefaultTestRunner{
-		logFilter: NewLogFilter(),
+		logFilter:     NewLogFilter(),
+		config:        config,
+		projectsDir:   config.ProjectsDir,
+		dockerCheck:   dockerInfo,
+		imageCheck:    composeImageExists,
+		composeDetect: detectComposeCommand,
+		statsSample:   composeStats,
 	}
 }
 
+// SetFastRerun toggles reusing the existing test image for subsequent runs
+func (r *DefaultTestRunner) SetFastRerun(enabled bool) {
+	r.config.FastRerun = enabled
+}
+
+// SetReportFormatCache sets where detected report formats are remembered between runs
+func (r *DefaultTestRunner) SetReportFormatCache(cache ReportFormatCache) {
+	r.formatCache = cache
+}
+
+// SetTestServiceSource sets where the compose service that runs each project's tests is configured
+func (r *DefaultTestRunner) SetTestServiceSource(services TestServiceSource) {
+	r.services = services
+}
+
+// testService returns the compose service configured to run the project's tests, or "" for all services
+func (r *DefaultTestRunner) testService(projectID string) string {
+	if r.services == nil {
+		return ""
+	}
+	return strings.TrimSpace(r.services.GetTestService(projectID))
+}
+
+// FastRerun reports whether fast rerun mode is enabled
+func (r *DefaultTestRunner) FastRerun() bool {
+	return r.config.FastRerun
+}
+
+// DebugTests reports whether tests run with maximum diagnostics
+func (r *DefaultTestRunner) DebugTests() bool {
+	return r.config.DebugTests
+}
+
 // RunTests executes tests for a project using docker-compose
 func (r *DefaultTestRunner) RunTests(project Project, progressCallback func(string)) (*testreport.ParseResult, error) {
+	return r.run(project, "", progressCallback)
+}
+
+// RunTest re-runs a single test from a previous run and returns its new result
+func (r *DefaultTestRunner) RunTest(project Project, test testreport.TestResult, progressCallback func(string)) (*testreport.TestResult, error) {
+	selector := TestSelector(project.Language, test)
+	if progressCallback != nil {
+		progressCallback(fmt.Sprintf("Selecting test: %s=%s", TestSelectorEnv, selector))
+	}
+
+	result, err := r.run(project, selector, progressCallback)
+	if err != nil {
+		return nil, err
+	}
+
+	rerun := result.FindTest(test.ClassName, test.Name)
+	if rerun == nil {
+		return nil, fmt.Errorf("test %s was not in the report; check that %s forwards %s to the test command", test.Name, composeFileName, TestSelectorEnv)
+	}
+	return rerun, nil
+}
+
+// run executes the project's tests, limited to the selected test when selector is set
+func (r *DefaultTestRunner) run(project Project, selector string, progressCallback func(string)) (*testreport.ParseResult, error) {
+	if !r.claim(project.ID) {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyRunning, project.Name)
+	}
+	defer r.release(project.ID)
+
 	// Check Docker Desktop status before proceeding
 	if err := r.checkDockerStatus(progressCallback); err != nil {
 		return nil, fmt.Errorf("Dependency check failed: %w", err)
@@ -36,6 +173,10 @@ func (r *DefaultTestRunner) RunTests(project Project, progressCallback func(stri
 		return nil, fmt.Errorf("failed to find project directory: %w", err)
 	}
 
+	if err := r.checkComposeFile(projectDir); err != nil {
+		return nil, err
+	}
+
 	// Create log file for this test run
 	logFile, err := r.createLogFile(projectDir, project)
 	if err != nil {
@@ -50,12 +191,19 @@ func (r *DefaultTestRunner) RunTests(project Project, progressCallback func(stri
 	}()
 
 	// Run docker-compose with filtered output
-	if err := r.runDockerCompose(projectDir, logFile, progressCallback); err != nil {
+	usage, err := r.runDockerCompose(projectDir, r.testService(project.ID), selector, project.Language, logFile, progressCallback)
+	r.recordUsage(project.ID, usage)
+	if err != nil {
 		return nil, fmt.Errorf("failed to run tests: %w", err)
 	}
 
 	// Parse test results - this will verify tests actually ran
 	result, err := r.parseTestResults(project, projectDir)
+	var parseErr *ReportParseError
+	if errors.As(err, &parseErr) {
+		// The tests ran; only their report is unreadable
+		return nil, err
+	}
 	if err != nil {
 		// If no test report found, docker-compose may have failed silently
 		return nil, fmt.Errorf("tests may not have run properly - no recent test report found: %w", err)
@@ -64,34 +212,109 @@ func (r *DefaultTestRunner) RunTests(project Project, progressCallback func(stri
 	return result, nil
 }
 
+// claim marks a project as running, reporting false if it already was
+func (r *DefaultTestRunner) claim(projectID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.active[projectID] {
+		return false
+	}
+	if r.active == nil {
+		r.active = make(map[string]bool)
+	}
+	r.active[projectID] = true
+	return true
+}
+
+// release marks a project's run as finished
+func (r *DefaultTestRunner) release(projectID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.active, projectID)
+}
+
+// recordUsage keeps the resource usage of a project's last run, forgetting it when there is none
+func (r *DefaultTestRunner) recordUsage(projectID string, usage *ResourceUsage) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if usage == nil {
+		delete(r.usage, projectID)
+		return
+	}
+	if r.usage == nil {
+		r.usage = make(map[string]ResourceUsage)
+	}
+	r.usage[projectID] = *usage
+}
+
+// LastUsage returns the resource usage of the project's last run, if stats were enabled for it
+func (r *DefaultTestRunner) LastUsage(project Project) (ResourceUsage, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	usage, ok := r.usage[project.ID]
+	return usage, ok
+}
+
 // checkDockerStatus checks if Docker Desktop is running (no user interaction)
 func (r *DefaultTestRunner) checkDockerStatus(progressCallback func(string)) error {
 	if progressCallback != nil {
 		progressCallback("Checking Docker Desktop status...")
 	}
 
-	// Check if Docker is running by running 'docker info'
-	cmd := exec.Command("docker", "info")
-	if err := cmd.Run(); err == nil {
-		if progressCallback != nil {
-			progressCallback("Docker Desktop is running")
-		}
-		return nil
+	if err := r.dockerCheck(); err != nil {
+		return err
+	}
+
+	if progressCallback != nil {
+		progressCallback("Docker Desktop is running")
+	}
+	return nil
+}
+
+// CheckDocker reports whether the container engine is installed and running
+func (r *DefaultTestRunner) CheckDocker() error {
+	return r.dockerCheck()
+}
+
+// ComposeCommand resolves the docker compose invocation used for test runs
+func (r *DefaultTestRunner) ComposeCommand() ([]string, error) {
+	return r.composeDetect()
+}
+
+// dockerInfo checks that the docker CLI is installed and the daemon answers 'docker info'
+func dockerInfo() error {
+	if _, err := exec.LookPath("docker"); err != nil {
+		return fmt.Errorf("docker executable not found in PATH")
 	}
 
-	return fmt.Errorf("Docker Desktop is not running")
+	if err := exec.Command("docker", "info").Run(); err != nil {
+		return fmt.Errorf("Docker Desktop is not running")
+	}
+	return nil
+}
+
+// projectsBaseDir returns the directory that holds all downloaded projects
+func (r *DefaultTestRunner) projectsBaseDir() (string, error) {
+	if r.projectsDir != "" {
+		return r.projectsDir, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, "404skill_projects"), nil
 }
 
 // findProjectDirectory locates the project directory in the user's home directory
 func (r *DefaultTestRunner) findProjectDirectory(project Project) (string, error) {
-	home, err := os.UserHomeDir()
+	base, err := r.projectsBaseDir()
 	if err != nil {
-		return "", fmt.Errorf("failed to get home directory: %w", err)
+		return "", err
 	}
 
 	repo := strings.ToLower(strings.ReplaceAll(project.Name, " ", "_"))
 	projectDirName := fmt.Sprintf("%s_%s", repo, project.ID)
-	base := filepath.Join(home, "404skill_projects")
 
 	entries, err := os.ReadDir(base)
 	if err != nil {
@@ -107,49 +330,179 @@ func (r *DefaultTestRunner) findProjectDirectory(project Project) (string, error
 	return "", fmt.Errorf("project directory not found for '%s'", projectDirName)
 }
 
-// runDockerCompose executes docker-compose up with build and abort-on-container-exit flags
-func (r *DefaultTestRunner) runDockerCompose(projectDir string, logFile *os.File, progressCallback func(string)) error {
+// checkComposeFile verifies the project ships the compose file used to run its tests
+func (r *DefaultTestRunner) checkComposeFile(projectDir string) error {
+	composePath := filepath.Join(projectDir, composeFileName)
+	info, err := os.Stat(composePath)
+	if err != nil || info.IsDir() {
+		return fmt.Errorf("%s not found in %s", composeFileName, projectDir)
+	}
+	return nil
+}
+
+// composeUsesDebugArgs reports whether the project's compose file references DebugArgsEnv; the
+// debug flags only reach the test command through it
+func composeUsesDebugArgs(projectDir string) bool {
+	data, err := os.ReadFile(filepath.Join(projectDir, composeFileName))
+	return err == nil && strings.Contains(string(data), DebugArgsEnv)
+}
+
+// reportsDirectory returns the directory the test harness writes its reports to
+func (r *DefaultTestRunner) reportsDirectory(project Project) (string, error) {
+	base, err := r.projectsBaseDir()
+	if err != nil {
+		return "", err
+	}
+
+	repo := strings.ToLower(strings.ReplaceAll(project.Name, " ", "_"))
+	return filepath.Join(base, ".tests", fmt.Sprintf("%s_%s", repo, project.ID), "test-reports"), nil
+}
+
+// checkReportsWritable verifies test reports can be written to the given directory
+func (r *DefaultTestRunner) checkReportsWritable(reportsDir string) error {
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		return fmt.Errorf("reports directory %s cannot be created: %w", reportsDir, err)
+	}
+
+	probe, err := os.CreateTemp(reportsDir, ".write-check-*")
+	if err != nil {
+		return fmt.Errorf("reports directory %s is not writable: %w", reportsDir, err)
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+	return nil
+}
+
+// Validate runs the pre-flight checks for a project without running any tests
+func (r *DefaultTestRunner) Validate(project Project) *ValidationReport {
+	report := &ValidationReport{Project: project}
+
+	projectDir, err := r.findProjectDirectory(project)
+	report.add("Project directory", err, projectDir)
+
+	if err != nil {
+		report.add("Compose file", fmt.Errorf("skipped: project directory not found"), "")
+	} else {
+		report.add("Compose file", r.checkComposeFile(projectDir), filepath.Join(projectDir, composeFileName))
+	}
+
+	report.add("Docker", r.checkDockerStatus(nil), "docker is installed and running")
+
+	reportsDir, err := r.reportsDirectory(project)
+	if err == nil {
+		err = r.checkReportsWritable(reportsDir)
+	}
+	report.add("Reports directory", err, reportsDir)
+
+	return report
+}
+
+// buildBackend describes the image builder used for test runs
+func (r *DefaultTestRunner) buildBackend() string {
+	if r.config.BuildKit {
+		return "BuildKit"
+	}
+	return "classic builder"
+}
+
+// runDockerCompose executes docker-compose up with build and abort-on-container-exit flags.
+// When resource stats are enabled it also returns the run's duration and peak usage.
+func (r *DefaultTestRunner) runDockerCompose(projectDir, service, selector, language string, logFile *os.File, progressCallback func(string)) (*ResourceUsage, error) {
 	if progressCallback != nil {
 		progressCallback("Starting docker-compose...")
 	}
 
-	cmd := exec.Command("docker", "compose", "-f", "docker-compose.test.yml", "up", "--build", "--abort-on-container-exit")
-	cmd.Dir = projectDir
+	compose, err := r.composeDetect()
+	if err != nil {
+		return nil, err
+	}
+
+	build := true
+	if r.config.FastRerun {
+		build = !r.imageCheck(compose, projectDir)
+		if build && progressCallback != nil {
+			progressCallback("No existing test image found, falling back to a full build")
+		}
+	}
+
+	cmd := r.composeCommand(compose, projectDir, build, service, selector, language)
+	commandLine := strings.Join(cmd.Args, " ")
 
 	if progressCallback != nil {
-		progressCallback(fmt.Sprintf("Running: docker compose up --build --abort-on-container-exit"))
+		progressCallback(fmt.Sprintf("Running: %s", commandLine))
 		progressCallback(fmt.Sprintf("Working directory: %s", projectDir))
+		progressCallback(fmt.Sprintf("Build backend: %s", r.buildBackend()))
+		if service != "" {
+			progressCallback(fmt.Sprintf("Test service: %s", service))
+		}
+		if r.config.DebugTests {
+			progressCallback(fmt.Sprintf("Debug mode: %s=%q", DebugArgsEnv, strings.Join(DebugFlags(language), " ")))
+			if !composeUsesDebugArgs(projectDir) {
+				progressCallback(fmt.Sprintf("Warning: %s does not use %s, so the debug flags have no effect; append $%s to the test command", composeFileName, DebugArgsEnv, DebugArgsEnv))
+			}
+		}
 	}
 
 	// Log the command being run
 	if logFile != nil {
-		logFile.WriteString(fmt.Sprintf("Command: docker compose up --build --abort-on-container-exit\n"))
-		logFile.WriteString(fmt.Sprintf("Working Directory: %s\n\n", projectDir))
+		logFile.WriteString(fmt.Sprintf("Command: %s\n", commandLine))
+		logFile.WriteString(fmt.Sprintf("Working Directory: %s\n", projectDir))
+		logFile.WriteString(fmt.Sprintf("Build Backend: %s\n", r.buildBackend()))
+		if service != "" {
+			logFile.WriteString(fmt.Sprintf("Test Service: %s\n", service))
+		}
+		if r.config.DebugTests {
+			logFile.WriteString(fmt.Sprintf("Debug Flags: %s\n", strings.Join(DebugFlags(language), " ")))
+		}
+		logFile.WriteString("\n")
 		logFile.WriteString("=== OUTPUT ===\n")
 	}
 
 	// Create pipes to capture output in real-time
 	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return fmt.Errorf("failed to create stdout pipe: %w", err)
+		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
 	}
 
 	stderr, err := cmd.StderrPipe()
 	if err != nil {
-		return fmt.Errorf("failed to create stderr pipe: %w", err)
+		return nil, fmt.Errorf("failed to create stderr pipe: %w", err)
 	}
 
 	// Start the command
 	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("failed to start docker-compose: %w", err)
+		return nil, fmt.Errorf("failed to start docker-compose: %w", err)
+	}
+
+	// Stop the run once it exceeds the timeout; an interrupt lets compose stop the containers
+	var timedOut atomic.Bool
+	if r.config.RunTimeout > 0 {
+		timer := time.AfterFunc(r.config.RunTimeout, func() {
+			timedOut.Store(true)
+			if err := cmd.Process.Signal(os.Interrupt); err != nil {
+				_ = cmd.Process.Kill()
+			}
+		})
+		defer timer.Stop()
 	}
 
-	// Track if tests were actually executed
-	testsExecuted := false
-	testsUpToDate := false
+	// Sample the containers while they run; the image build counts towards the duration
+	started := time.Now()
+	var sampler *usageSampler
+	if r.config.ResourceStats {
+		sampler = startUsageSampler(func() ([]ContainerStats, error) {
+			return r.statsSample(compose, projectDir)
+		}, statsInterval)
+	}
+
+	// Track if tests were actually executed and whether a container exited
+	watch := &composeWatch{}
+	var readers sync.WaitGroup
+	readers.Add(2)
 
 	// Stream stdout in real-time
 	go func() {
+		defer readers.Done()
 		scanner := bufio.NewScanner(stdout)
 		for scanner.Scan() {
 			line := scanner.Text()
@@ -159,20 +512,13 @@ func (r *DefaultTestRunner) runDockerCompose(projectDir string, logFile *os.File
 			if logFile != nil {
 				logFile.WriteString(fmt.Sprintf("STDOUT: %s\n", line))
 			}
-
-			// Check if tests are running or up-to-date
-			if strings.Contains(line, "> Task :test") {
-				if strings.Contains(line, "UP-TO-DATE") {
-					testsUpToDate = true
-				} else {
-					testsExecuted = true
-				}
-			}
+			watch.observe(line)
 		}
 	}()
 
 	// Stream stderr in real-time
 	go func() {
+		defer readers.Done()
 		scanner := bufio.NewScanner(stderr)
 		for scanner.Scan() {
 			line := scanner.Text()
@@ -182,12 +528,29 @@ func (r *DefaultTestRunner) runDockerCompose(projectDir string, logFile *os.File
 			if logFile != nil {
 				logFile.WriteString(fmt.Sprintf("STDERR: %s\n", line))
 			}
+			watch.observe(line)
 		}
 	}()
 
-	// Wait for command to finish
+	// Read the output to the end before waiting: Wait closes the pipes, and lines still
+	// buffered in them would be lost
+	readers.Wait()
 	err = cmd.Wait()
+	var usage *ResourceUsage
+	if sampler != nil {
+		summary := summarizeUsage(started, time.Now(), sampler.stop())
+		usage = &summary
+	}
 	exitCode := cmd.ProcessState.ExitCode()
+	testsExecuted, testsUpToDate, _ := watch.state()
+
+	if timedOut.Load() {
+		timeoutErr := watch.timeoutError(r.config.RunTimeout)
+		if logFile != nil {
+			logFile.WriteString(fmt.Sprintf("\n=== COMMAND TIMED OUT ===\n%v\n", timeoutErr))
+		}
+		return nil, timeoutErr
+	}
 
 	if progressCallback != nil {
 		progressCallback(fmt.Sprintf("Docker-compose finished with exit code: %d", exitCode))
@@ -207,13 +570,16 @@ func (r *DefaultTestRunner) runDockerCompose(projectDir string, logFile *os.File
 		logFile.WriteString(fmt.Sprintf("Tests Executed: %t\n", testsExecuted))
 		logFile.WriteString(fmt.Sprintf("Tests Up-To-Date: %t\n", testsUpToDate))
 		logFile.WriteString(fmt.Sprintf("Finished: %s\n", time.Now().Format("2006-01-02 15:04:05")))
+		if usage != nil {
+			logFile.WriteString(fmt.Sprintf("Resource Usage: %s\n", usage.Summary()))
+		}
 	}
 
 	// Exit code 0 = all tests passed
 	// Exit code 1 = tests ran, but some failed (this is normal!)
 	// Other exit codes = actual docker-compose failure
 	if exitCode != 0 && exitCode != 1 {
-		return fmt.Errorf("docker-compose failed with exit code %d", exitCode)
+		return nil, fmt.Errorf("docker-compose failed with exit code %d", exitCode)
 	}
 
 	if progressCallback != nil {
@@ -228,67 +594,128 @@ func (r *DefaultTestRunner) runDockerCompose(projectDir string, logFile *os.File
 		} else {
 			progressCallback("⚠️  Tests completed - some may have failed")
 		}
+		if usage != nil {
+			progressCallback(usage.Summary())
+		}
 		if logFile != nil {
 			progressCallback(fmt.Sprintf("📝 Full log saved to: %s", logFile.Name()))
 		}
 	}
 
-	return nil
+	return usage, nil
 }
 
-// parseTestResults finds and parses the XML test report
+// parseTestResults finds and parses the newest test report. The report format (JUnit XML, TAP
+// or go test JSON) is detected from the file headers once per project and then remembered.
 func (r *DefaultTestRunner) parseTestResults(project Project, projectDir string) (*testreport.ParseResult, error) {
-	home, err := os.UserHomeDir()
+	report, err := r.latestReport(project)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get home directory: %w", err)
+		return nil, err
 	}
 
-	repo := strings.ToLower(strings.ReplaceAll(project.Name, " ", "_"))
-	base := filepath.Join(home, "404skill_projects")
+	// Check if the test report is recent (within last 5 minutes)
+	// This confirms tests actually ran and weren't just old files
+	if time.Since(report.ModTime) > 5*time.Minute {
+		return nil, fmt.Errorf("test report found but is too old (%v) - tests may not have run", report.ModTime)
+	}
 
-	reportsDir := filepath.Join(base, ".tests", fmt.Sprintf("%s_%s", repo, project.ID), "test-reports")
+	parser := testreport.NewParser()
+	result, err := parser.ParseFileAs(report.Path, report.Format)
+	if err != nil {
+		return nil, &ReportParseError{Path: report.Path, Format: report.Format, Err: err}
+	}
+	r.rememberFormat(project, report.Format)
+
+	return result, nil
+}
 
-	entries, err := os.ReadDir(reportsDir)
+// latestReport returns the report the next run's results would be read from, ErrNoReport when there is none
+func (r *DefaultTestRunner) latestReport(project Project) (testreport.ReportFile, error) {
+	reportsDir, err := r.reportsDirectory(project)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read reports directory: %w", err)
+		return testreport.ReportFile{}, err
 	}
+	reports, err := testreport.FindReports(reportsDir)
+	if err != nil {
+		return testreport.ReportFile{}, err
+	}
+	if len(reports) == 0 {
+		return testreport.ReportFile{}, fmt.Errorf("%w in %s", ErrNoReport, reportsDir)
+	}
+	return r.selectReport(project, reports), nil
+}
 
-	var xmlPath string
-	var mostRecentTime time.Time
+// LatestReportTime returns when the project's newest report was written, so a watcher can poll it cheaply
+func (r *DefaultTestRunner) LatestReportTime(project Project) (time.Time, error) {
+	report, err := r.latestReport(project)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return report.ModTime, nil
+}
 
-	for _, entry := range entries {
-		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".xml") {
-			fullPath := filepath.Join(reportsDir, entry.Name())
-			info, err := entry.Info()
-			if err != nil {
-				continue
-			}
+// LoadLatestReport parses the project's newest report however old it is, without running any tests
+func (r *DefaultTestRunner) LoadLatestReport(project Project) (*testreport.ParseResult, error) {
+	report, err := r.latestReport(project)
+	if err != nil {
+		return nil, err
+	}
+	result, err := testreport.NewParser().ParseFileAs(report.Path, report.Format)
+	if err != nil {
+		return nil, &ReportParseError{Path: report.Path, Format: report.Format, Err: err}
+	}
+	r.rememberFormat(project, report.Format)
+	return result, nil
+}
 
-			// Find the most recent XML file
-			if info.ModTime().After(mostRecentTime) {
-				mostRecentTime = info.ModTime()
-				xmlPath = fullPath
+// sameRunWindow is how close to the newest report another report must be written to count as
+// output of the same run, e.g. a JUnit file next to go test JSON
+const sameRunWindow = 5 * time.Second
+
+// selectReport picks the newest report, preferring the project's remembered format among reports
+// written by the same run. A newer report in another format means the project's output changed,
+// so the newest report decides. It only reads the cache: watchers call it on every poll.
+func (r *DefaultTestRunner) selectReport(project Project, reports []testreport.ReportFile) testreport.ReportFile {
+	newest := reports[0]
+	if r.formatCache != nil {
+		if format, ok := testreport.LookupFormat(r.formatCache.GetReportFormat(project.ID)); ok {
+			for _, report := range reports {
+				if report.Format == format && newest.ModTime.Sub(report.ModTime) <= sameRunWindow {
+					return report
+				}
 			}
 		}
 	}
 
-	if xmlPath == "" {
-		return nil, fmt.Errorf("no XML test report found in %s", reportsDir)
-	}
+	return newest
+}
 
-	// Check if the test report is recent (within last 5 minutes)
-	// This confirms tests actually ran and weren't just old files
-	if time.Since(mostRecentTime) > 5*time.Minute {
-		return nil, fmt.Errorf("test report found but is too old (%v) - tests may not have run", mostRecentTime)
+// rememberFormat records the format of a report that parsed, writing the cache only when it changed
+func (r *DefaultTestRunner) rememberFormat(project Project, format testreport.Format) {
+	if r.formatCache == nil || r.formatCache.GetReportFormat(project.ID) == string(format) {
+		return
 	}
+	// Failing to remember the format only means detecting it again next run
+	_ = r.formatCache.SetReportFormat(project.ID, string(format))
+}
 
-	parser := testreport.NewParser()
-	result, err := parser.ParseFile(xmlPath)
+// LatestLogPath returns the most recent saved run log for a project
+func (r *DefaultTestRunner) LatestLogPath(project Project) (string, error) {
+	projectDir, err := r.findProjectDirectory(project)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse test report: %w", err)
+		return "", fmt.Errorf("failed to find project directory: %w", err)
 	}
 
-	return result, nil
+	// Timestamps in log names sort chronologically, so the last match is the newest
+	matches, err := filepath.Glob(filepath.Join(projectDir, "test-logs", "test-run_*.log"))
+	if err != nil {
+		return "", fmt.Errorf("failed to list test logs: %w", err)
+	}
+	if len(matches) == 0 {
+		return "", fmt.Errorf("no test logs found for '%s'", project.Name)
+	}
+	sort.Strings(matches)
+	return matches[len(matches)-1], nil
 }
 
 // createLogFile creates a timestamped log file for the test run
@@ -1,10 +1,13 @@
 package testrunner
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"404skill-cli/testreport"
 )
@@ -253,7 +256,657 @@ func TestDefaultTestRunner_RunTests_InvalidProject(t *testing.T) {
 	}
 }
 
+// newValidationRunner returns a runner rooted at a temp projects dir with a healthy docker
+func newValidationRunner(t *testing.T) (*DefaultTestRunner, Project, string) {
+	t.Helper()
+	base := t.TempDir()
+	project := Project{ID: "p1", Name: "Sample Project", Language: "go"}
+
+	projectDir := filepath.Join(base, "sample_project_p1")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatalf("Failed to create project dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectDir, composeFileName), []byte("services: {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to write compose file: %v", err)
+	}
+
+	runner := NewDefaultTestRunner()
+	runner.projectsDir = base
+	runner.dockerCheck = func() error { return nil }
+	return runner, project, base
+}
+
+// findCheck returns the named check from a report
+func findCheck(t *testing.T, report *ValidationReport, name string) ValidationCheck {
+	t.Helper()
+	for _, check := range report.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+	t.Fatalf("Check %q not found in report", name)
+	return ValidationCheck{}
+}
+
+func TestDefaultTestRunner_Validate_AllPassing(t *testing.T) {
+	runner, project, _ := newValidationRunner(t)
+
+	report := runner.Validate(project)
+
+	if !report.OK() {
+		t.Errorf("Expected all checks to pass, failed: %+v", report.Failed())
+	}
+	if len(report.Checks) != 4 {
+		t.Errorf("Expected 4 checks, got %d", len(report.Checks))
+	}
+}
+
+func TestDefaultTestRunner_Validate_MissingProjectDirectory(t *testing.T) {
+	runner, _, _ := newValidationRunner(t)
+
+	report := runner.Validate(Project{ID: "missing", Name: "Missing", Language: "go"})
+
+	if report.OK() {
+		t.Fatal("Expected validation to fail")
+	}
+	if findCheck(t, report, "Project directory").Passed {
+		t.Error("Expected project directory check to fail")
+	}
+	if findCheck(t, report, "Compose file").Passed {
+		t.Error("Expected compose file check to be skipped as failed")
+	}
+}
+
+func TestDefaultTestRunner_Validate_MissingComposeFile(t *testing.T) {
+	runner, project, base := newValidationRunner(t)
+	os.Remove(filepath.Join(base, "sample_project_p1", composeFileName))
+
+	report := runner.Validate(project)
+
+	check := findCheck(t, report, "Compose file")
+	if check.Passed {
+		t.Error("Expected compose file check to fail")
+	}
+	if !strings.Contains(check.Detail, composeFileName) {
+		t.Errorf("Expected detail to mention %s, got %q", composeFileName, check.Detail)
+	}
+	if !findCheck(t, report, "Project directory").Passed {
+		t.Error("Expected project directory check to pass")
+	}
+}
+
+func TestDefaultTestRunner_Validate_DockerUnavailable(t *testing.T) {
+	runner, project, _ := newValidationRunner(t)
+	runner.dockerCheck = func() error { return fmt.Errorf("Docker Desktop is not running") }
+
+	report := runner.Validate(project)
+
+	check := findCheck(t, report, "Docker")
+	if check.Passed {
+		t.Error("Expected docker check to fail")
+	}
+	if check.Detail != "Docker Desktop is not running" {
+		t.Errorf("Unexpected detail: %q", check.Detail)
+	}
+}
+
+func TestDefaultTestRunner_Validate_ReportsDirectoryNotWritable(t *testing.T) {
+	runner, project, base := newValidationRunner(t)
+	// A regular file where the .tests directory should be makes the reports dir uncreatable
+	if err := os.WriteFile(filepath.Join(base, ".tests"), []byte("x"), 0644); err != nil {
+		t.Fatalf("Failed to create blocking file: %v", err)
+	}
+
+	report := runner.Validate(project)
+
+	if findCheck(t, report, "Reports directory").Passed {
+		t.Error("Expected reports directory check to fail")
+	}
+	if report.OK() {
+		t.Error("Expected report not to be OK")
+	}
+}
+
+// hasEnv reports whether the command environment contains the given KEY=VALUE entry
+func hasEnv(env []string, entry string) bool {
+	for _, e := range env {
+		if e == entry {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDefaultTestRunner_composeCommand_BuildKitEnabled(t *testing.T) {
+	runner := NewDefaultTestRunner()
+
+	cmd := runner.composeCommand(composePluginCommand, "/tmp/project", true, "", "", "")
+
+	if cmd.Dir != "/tmp/project" {
+		t.Errorf("Expected working directory /tmp/project, got %s", cmd.Dir)
+	}
+	for _, entry := range []string{"DOCKER_BUILDKIT=1", "COMPOSE_DOCKER_CLI_BUILD=1"} {
+		if !hasEnv(cmd.Env, entry) {
+			t.Errorf("Expected %s in command environment", entry)
+		}
+	}
+	if runner.buildBackend() != "BuildKit" {
+		t.Errorf("Expected BuildKit backend, got %s", runner.buildBackend())
+	}
+}
+
+func TestDefaultTestRunner_composeCommand_BuildKitDisabled(t *testing.T) {
+	runner := NewDefaultTestRunnerWithConfig(RunnerConfig{BuildKit: false})
+
+	cmd := runner.composeCommand(composePluginCommand, "/tmp/project", true, "", "", "")
+
+	if hasEnv(cmd.Env, "DOCKER_BUILDKIT=1") || hasEnv(cmd.Env, "COMPOSE_DOCKER_CLI_BUILD=1") {
+		t.Error("Expected BuildKit variables to be absent when disabled")
+	}
+	if runner.buildBackend() != "classic builder" {
+		t.Errorf("Expected classic builder backend, got %s", runner.buildBackend())
+	}
+}
+
+// containsArg reports whether args contains the given argument
+func containsArg(args []string, arg string) bool {
+	for _, a := range args {
+		if a == arg {
+			return true
+		}
+	}
+	return false
+}
+
+func TestComposeArgs_BuildFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		build     bool
+		wantBuild bool
+	}{
+		{name: "normal mode rebuilds the image", build: true, wantBuild: true},
+		{name: "fast mode reuses the image", build: false, wantBuild: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := composeArgs(tt.build, "")
+
+			if containsArg(args, "--build") != tt.wantBuild {
+				t.Errorf("Expected --build present=%v, got args %v", tt.wantBuild, args)
+			}
+			if !containsArg(args, "--abort-on-container-exit") {
+				t.Errorf("Expected --abort-on-container-exit in args %v", args)
+			}
+		})
+	}
+}
+
+func TestComposeArgs_TestService(t *testing.T) {
+	// Act
+	all := composeArgs(true, "")
+	targeted := composeArgs(true, "tests")
+
+	// Assert
+	want := "-f " + composeFileName + " up --build --abort-on-container-exit --exit-code-from tests tests"
+	if got := strings.Join(targeted, " "); got != want {
+		t.Errorf("Expected args %q, got %q", want, got)
+	}
+	if containsArg(all, "--exit-code-from") || all[len(all)-1] != "--abort-on-container-exit" {
+		t.Errorf("Expected every service to run by default, got args %v", all)
+	}
+}
+
+type memoryServiceSource map[string]string
+
+func (s memoryServiceSource) GetTestService(projectID string) string { return s[projectID] }
+
+func TestDefaultTestRunner_composeCommand_ConfiguredTestService(t *testing.T) {
+	// Arrange
+	runner := NewDefaultTestRunner()
+	runner.SetTestServiceSource(memoryServiceSource{"p1": " tests "})
+
+	// Act
+	configured := runner.composeCommand(composePluginCommand, "/tmp/project", false, runner.testService("p1"), "", "")
+	unconfigured := runner.composeCommand(composePluginCommand, "/tmp/project", false, runner.testService("p2"), "", "")
+
+	// Assert
+	if args := configured.Args; args[len(args)-1] != "tests" || !containsArg(args, "--exit-code-from") {
+		t.Errorf("Expected the tests service to be targeted, got args %v", args)
+	}
+	if containsArg(unconfigured.Args, "--exit-code-from") {
+		t.Errorf("Expected every service to run without a configured service, got args %v", unconfigured.Args)
+	}
+}
+
+func TestDefaultTestRunner_SetFastRerun(t *testing.T) {
+	runner := NewDefaultTestRunner()
+	var _ FastRerunner = runner
+
+	if runner.FastRerun() {
+		t.Error("Expected fast rerun to be off by default")
+	}
+
+	runner.SetFastRerun(true)
+
+	if !runner.FastRerun() {
+		t.Error("Expected fast rerun to be on after enabling it")
+	}
+}
+
+func TestSelectComposeCommand(t *testing.T) {
+	tests := []struct {
+		name                string
+		pluginAvailable     bool
+		standaloneAvailable bool
+		expected            []string
+		expectError         bool
+	}{
+		{name: "plugin preferred", pluginAvailable: true, standaloneAvailable: true, expected: []string{"docker", "compose"}},
+		{name: "plugin only", pluginAvailable: true, expected: []string{"docker", "compose"}},
+		{name: "falls back to v1 binary", standaloneAvailable: true, expected: []string{"docker-compose"}},
+		{name: "neither available", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compose, err := selectComposeCommand(tt.pluginAvailable, tt.standaloneAvailable)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error when no compose is available")
+				}
+				if !strings.Contains(err.Error(), "upgrade") {
+					t.Errorf("Expected upgrade hint in error, got %q", err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if strings.Join(compose, " ") != strings.Join(tt.expected, " ") {
+				t.Errorf("Expected %v, got %v", tt.expected, compose)
+			}
+		})
+	}
+}
+
+func TestDefaultTestRunner_composeCommand_Standalone(t *testing.T) {
+	runner := NewDefaultTestRunner()
+
+	cmd := runner.composeCommand(composeStandaloneCommand, "/tmp/project", true, "", "", "")
+
+	if filepath.Base(cmd.Path) != "docker-compose" && cmd.Args[0] != "docker-compose" {
+		t.Errorf("Expected docker-compose binary, got %v", cmd.Args)
+	}
+	if cmd.Args[1] != "-f" {
+		t.Errorf("Expected compose args directly after binary, got %v", cmd.Args)
+	}
+}
+
+func TestDefaultTestRunner_LatestLogPath(t *testing.T) {
+	runner, project, base := newValidationRunner(t)
+	logsDir := filepath.Join(base, "sample_project_p1", "test-logs")
+	if err := os.MkdirAll(logsDir, 0755); err != nil {
+		t.Fatalf("Failed to create logs dir: %v", err)
+	}
+
+	_, err := runner.LatestLogPath(project)
+	if err == nil {
+		t.Error("Expected error when no logs exist")
+	}
+
+	for _, name := range []string{"test-run_go_2025-01-02_09-00-00.log", "test-run_go_2025-01-03_08-00-00.log", "test-run_go_2025-01-01_23-00-00.log"} {
+		if err := os.WriteFile(filepath.Join(logsDir, name), []byte("log"), 0644); err != nil {
+			t.Fatalf("Failed to write log: %v", err)
+		}
+	}
+
+	path, err := runner.LatestLogPath(project)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if filepath.Base(path) != "test-run_go_2025-01-03_08-00-00.log" {
+		t.Errorf("Expected newest log, got %s", filepath.Base(path))
+	}
+}
+
 // Helper function that mimics the formatting logic in the service
 func formatProjectName(name string, id string) string {
 	return strings.ToLower(strings.ReplaceAll(name, " ", "_")) + "_" + id
 }
+
+func TestTestSelector(t *testing.T) {
+	test := testreport.TestResult{Name: "test_create_entry", ClassName: "tests.test_api.TestTask2"}
+
+	tests := []struct {
+		language string
+		expected string
+	}{
+		{"Python", "test_create_entry"},
+		{"Go", "^test_create_entry$"},
+		{"TypeScript", "^test_create_entry$"},
+		{"Java", "TestTask2#test_create_entry"},
+		{"C#", "FullyQualifiedName~tests.test_api.TestTask2.test_create_entry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			if got := TestSelector(tt.language, test); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestComposeCommand_Selector(t *testing.T) {
+	// Arrange
+	runner := NewDefaultTestRunner()
+
+	// Act
+	selected := runner.composeCommand(composePluginCommand, "/tmp/project", false, "", "test_one", "")
+	full := runner.composeCommand(composePluginCommand, "/tmp/project", false, "", "", "")
+
+	// Assert
+	if !hasEnv(selected.Env, TestSelectorEnv+"=test_one") {
+		t.Error("Expected selector to be passed to the test container")
+	}
+	if len(selected.Env) != len(full.Env)+1 {
+		t.Errorf("Expected a full run to add no selector, got %d vs %d env entries", len(full.Env), len(selected.Env))
+	}
+}
+
+func TestDebugFlags(t *testing.T) {
+	tests := []struct {
+		language string
+		expected string
+	}{
+		{"python", "-vv --tb=long -rA"},
+		{"Java", "--info --stacktrace"},
+		{"kotlin", "--info --stacktrace"},
+		{"go", "-v"},
+		{"typescript", "--verbose"},
+		{"C#", "--verbosity detailed"},
+		{"cobol", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			if got := strings.Join(DebugFlags(tt.language), " "); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestComposeCommand_DebugTests(t *testing.T) {
+	// Arrange
+	config := DefaultRunnerConfig()
+	config.DebugTests = true
+	debugRunner := NewDefaultTestRunnerWithConfig(config)
+	normalRunner := NewDefaultTestRunner()
+
+	// Act
+	pytest := debugRunner.composeCommand(composePluginCommand, "/tmp/project", false, "", "", "python")
+	gradle := debugRunner.composeCommand(composePluginCommand, "/tmp/project", false, "", "", "java")
+	normal := normalRunner.composeCommand(composePluginCommand, "/tmp/project", false, "", "", "python")
+
+	// Assert
+	if !hasEnv(pytest.Env, DebugArgsEnv+"=-vv --tb=long -rA") {
+		t.Error("Expected pytest verbosity flags in debug mode")
+	}
+	if !hasEnv(gradle.Env, DebugArgsEnv+"=--info --stacktrace") {
+		t.Error("Expected gradle verbosity flags in debug mode")
+	}
+	if !hasEnv(pytest.Env, "BUILDKIT_PROGRESS=plain") {
+		t.Error("Expected plain build progress in debug mode")
+	}
+	for _, e := range normal.Env {
+		if strings.HasPrefix(e, DebugArgsEnv+"=") {
+			t.Errorf("Expected no debug flags outside debug mode, got %s", e)
+		}
+	}
+}
+
+func TestComposeUsesDebugArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		compose string
+		want    bool
+	}{
+		{"appended to the command", "services:\n  tests:\n    command: pytest $TEST_EXTRA_ARGS\n", true},
+		{"braced", "services:\n  tests:\n    command: sh -c \"go test ./... ${TEST_EXTRA_ARGS}\"\n", true},
+		{"not referenced", "services:\n  tests:\n    command: pytest\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, composeFileName), []byte(tt.compose), 0644); err != nil {
+				t.Fatalf("Failed to write compose file: %v", err)
+			}
+			if got := composeUsesDebugArgs(dir); got != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// memoryFormatCache is an in-memory ReportFormatCache
+type memoryFormatCache map[string]string
+
+func (c memoryFormatCache) GetReportFormat(projectID string) string { return c[projectID] }
+
+func (c memoryFormatCache) SetReportFormat(projectID, format string) error {
+	c[projectID] = format
+	return nil
+}
+
+func TestDefaultTestRunner_parseTestResults_DetectsAndRemembersFormat(t *testing.T) {
+	// Arrange
+	runner, project, base := newValidationRunner(t)
+	cache := memoryFormatCache{}
+	runner.SetReportFormatCache(cache)
+	reportsDir := filepath.Join(base, ".tests", "sample_project_p1", "test-reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+	tap := "TAP version 13\nok 1 - health\nnot ok 2 - create\n1..2\n"
+	if err := os.WriteFile(filepath.Join(reportsDir, "results.out"), []byte(tap), 0644); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+
+	// Act
+	result, err := runner.parseTestResults(project, filepath.Join(base, "sample_project_p1"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(result.PassedTests) != 1 || len(result.FailedTests) != 1 {
+		t.Errorf("Expected the TAP report to be parsed, got %+v", result.Suite.Results)
+	}
+	if cache["p1"] != string(testreport.FormatTAP) {
+		t.Errorf("Expected the TAP format to be remembered, got %q", cache["p1"])
+	}
+}
+
+func TestDefaultTestRunner_selectReport_PrefersRememberedFormat(t *testing.T) {
+	runner := NewDefaultTestRunner()
+	runner.SetReportFormatCache(memoryFormatCache{"p1": string(testreport.FormatJUnit)})
+	now := time.Now()
+	reports := []testreport.ReportFile{
+		{Path: "coverage.json", Format: testreport.FormatGoJSON, ModTime: now},
+		{Path: "results.xml", Format: testreport.FormatJUnit, ModTime: now.Add(-time.Second)},
+	}
+
+	report := runner.selectReport(Project{ID: "p1"}, reports)
+
+	if report.Path != "results.xml" {
+		t.Errorf("Expected the remembered JUnit report written by the same run, got %s", report.Path)
+	}
+}
+
+func TestDefaultTestRunner_selectReport_NewerFormatReplacesRemembered(t *testing.T) {
+	// Arrange - an old JUnit report is still there, but the project now writes TAP
+	runner := NewDefaultTestRunner()
+	cache := memoryFormatCache{"p1": string(testreport.FormatJUnit)}
+	runner.SetReportFormatCache(cache)
+	now := time.Now()
+	reports := []testreport.ReportFile{
+		{Path: "results.tap", Format: testreport.FormatTAP, ModTime: now},
+		{Path: "results.xml", Format: testreport.FormatJUnit, ModTime: now.Add(-10 * time.Minute)},
+	}
+
+	// Act
+	report := runner.selectReport(Project{ID: "p1"}, reports)
+
+	// Assert
+	if report.Path != "results.tap" {
+		t.Errorf("Expected the newest report, got %s", report.Path)
+	}
+	if cache["p1"] != string(testreport.FormatJUnit) {
+		t.Errorf("Expected selecting a report not to change the remembered format, got %q", cache["p1"])
+	}
+}
+
+// countingFormatCache counts how often the remembered format is written
+type countingFormatCache struct {
+	memoryFormatCache
+	writes int
+}
+
+func (c *countingFormatCache) SetReportFormat(projectID, format string) error {
+	c.writes++
+	return c.memoryFormatCache.SetReportFormat(projectID, format)
+}
+
+func TestDefaultTestRunner_ReportWatching_WritesFormatOnlyWhenItChanges(t *testing.T) {
+	// Arrange - the project moved from JUnit to TAP
+	runner, project, base := newValidationRunner(t)
+	cache := &countingFormatCache{memoryFormatCache: memoryFormatCache{"p1": string(testreport.FormatJUnit)}}
+	runner.SetReportFormatCache(cache)
+	reportsDir := filepath.Join(base, ".tests", "sample_project_p1", "test-reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(reportsDir, "results.tap"), []byte("TAP version 13\nok 1 - health\n1..1\n"), 0644); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+
+	// Act - a watcher polls several times and loads the report twice
+	for i := 0; i < 3; i++ {
+		if _, err := runner.LatestReportTime(project); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	writesWhilePolling := cache.writes
+	for i := 0; i < 2; i++ {
+		if _, err := runner.LoadLatestReport(project); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	// Assert
+	if writesWhilePolling != 0 {
+		t.Errorf("Expected polling not to write the format, got %d writes", writesWhilePolling)
+	}
+	if cache.writes != 1 || cache.memoryFormatCache["p1"] != string(testreport.FormatTAP) {
+		t.Errorf("Expected the TAP format to be written once, got %d writes and %q", cache.writes, cache.memoryFormatCache["p1"])
+	}
+}
+
+func TestDefaultTestRunner_RunTests_RejectsConcurrentRunOfSameProject(t *testing.T) {
+	// Arrange - the first run blocks in its docker check until released
+	runner, project, _ := newValidationRunner(t)
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	calls := 0
+	runner.dockerCheck = func() error {
+		calls++
+		if calls == 1 {
+			close(started)
+			<-unblock
+		}
+		return errors.New("docker stopped")
+	}
+	firstDone := make(chan error)
+	go func() {
+		_, err := runner.RunTests(project, nil)
+		firstDone <- err
+	}()
+	<-started
+
+	// Act
+	_, secondErr := runner.RunTest(project, testreport.TestResult{Name: "test_a"}, nil)
+
+	// Assert
+	if !errors.Is(secondErr, ErrAlreadyRunning) {
+		t.Errorf("Expected the second run to be rejected, got: %v", secondErr)
+	}
+	other := Project{ID: "p2", Name: "Other Project", Language: "go"}
+	if _, err := runner.RunTests(other, nil); errors.Is(err, ErrAlreadyRunning) {
+		t.Error("Expected another project to be allowed to run")
+	}
+
+	close(unblock)
+	if err := <-firstDone; errors.Is(err, ErrAlreadyRunning) {
+		t.Errorf("Expected the first run to proceed, got: %v", err)
+	}
+	if _, err := runner.RunTests(project, nil); errors.Is(err, ErrAlreadyRunning) {
+		t.Error("Expected a new run to be allowed once the first finished")
+	}
+}
+
+func TestDefaultTestRunner_parseTestResults_MalformedReport(t *testing.T) {
+	// Arrange - a JUnit report cut short, as when the container is killed mid-write
+	runner, project, _ := newValidationRunner(t)
+	reportsDir, err := runner.reportsDirectory(project)
+	if err != nil {
+		t.Fatalf("Failed to resolve reports dir: %v", err)
+	}
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+	reportPath := filepath.Join(reportsDir, "results.xml")
+	truncated := `<?xml version="1.0"?><testsuite name="S" tests="2" timestamp="2024-03-20T10:00:00"><testcase name="a"/><testca`
+	if err := os.WriteFile(reportPath, []byte(truncated), 0644); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+
+	// Act
+	_, err = runner.parseTestResults(project, "")
+
+	// Assert
+	var parseErr *ReportParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("Expected a report parse error, got: %v", err)
+	}
+	if errors.Is(err, ErrNoReport) {
+		t.Error("Expected a malformed report not to count as a missing report")
+	}
+	if parseErr.Path != reportPath {
+		t.Errorf("Expected the report path %s, got %s", reportPath, parseErr.Path)
+	}
+	if _, err := os.Stat(parseErr.Path); err != nil {
+		t.Errorf("Expected the raw report to be kept for viewing: %v", err)
+	}
+}
+
+func TestDefaultTestRunner_parseTestResults_NoReport(t *testing.T) {
+	// Arrange
+	runner, project, _ := newValidationRunner(t)
+	reportsDir, _ := runner.reportsDirectory(project)
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+
+	// Act
+	_, err := runner.parseTestResults(project, "")
+
+	// Assert
+	var parseErr *ReportParseError
+	if !errors.Is(err, ErrNoReport) || errors.As(err, &parseErr) {
+		t.Errorf("Expected a missing report error, got: %v", err)
+	}
+}
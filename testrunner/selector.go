@@ -0,0 +1,38 @@
+package testrunner
+
+import (
+	"regexp"
+	"strings"
+
+	"404skill-cli/testreport"
+)
+
+// TestSelectorEnv is passed to the test container to run a single test. Compose files opt in by
+// forwarding it to the test command, e.g. `pytest -k "$TEST_SELECTOR"` or `go test -run "$TEST_SELECTOR"`.
+const TestSelectorEnv = "TEST_SELECTOR"
+
+// TestSelector returns the selection expression that runs only the given test in the project's language
+func TestSelector(language string, test testreport.TestResult) string {
+	switch languageFamily(language) {
+	case languageGo, languageJS:
+		// go test -run and jest -t / mocha --grep take an anchored regular expression
+		return "^" + regexp.QuoteMeta(test.Name) + "$"
+	case languageJava:
+		// mvn -Dtest / gradle --tests use Class#method
+		return simpleClassName(test.ClassName) + "#" + test.Name
+	case languageCSharp:
+		// dotnet test --filter
+		return "FullyQualifiedName~" + test.ClassName + "." + test.Name
+	default:
+		// pytest -k and most other runners select by test name
+		return test.Name
+	}
+}
+
+// simpleClassName strips any package qualifier from a class name (e.g. "test_api.TestTask1" -> "TestTask1")
+func simpleClassName(className string) string {
+	if i := strings.LastIndex(className, "."); i >= 0 {
+		return className[i+1:]
+	}
+	return className
+}
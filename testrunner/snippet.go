@@ -0,0 +1,61 @@
+package testrunner
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// nativeTestCommands holds each language's usual test command, for projects without a compose file
+var nativeTestCommands = map[string]string{
+	languageGo:     "go test ./...",
+	languageJS:     "npm test",
+	languageJava:   "./gradlew test",
+	languageCSharp: "dotnet test",
+	languagePython: "pytest",
+}
+
+// snippetComposeDetect resolves the compose invocation for snippets; replaced in tests
+var snippetComposeDetect = detectComposeCommand
+
+// TestCommand returns the command that runs a project's tests from its directory: the compose
+// command the CLI itself runs when the project ships a compose file, otherwise the language's
+// usual test command. It returns "" when neither applies.
+func TestCommand(projectDir, language, service string) string {
+	if info, err := os.Stat(filepath.Join(projectDir, composeFileName)); err == nil && !info.IsDir() {
+		// Without any compose installed, show the plugin form the install instructions lead to
+		compose, err := snippetComposeDetect()
+		if err != nil {
+			compose = composePluginCommand
+		}
+		args := append(append([]string{}, compose...), composeArgs(true, service)...)
+		return strings.Join(args, " ")
+	}
+	return nativeTestCommands[languageFamily(language)]
+}
+
+// TestSnippet returns a ready-to-paste `cd <dir> && <command>` line for running a project's
+// tests outside the CLI, or "" when there is no test command for it
+func TestSnippet(projectDir, language, service string) string {
+	command := TestCommand(projectDir, language, service)
+	if command == "" {
+		return ""
+	}
+	return "cd " + quotePath(projectDir, runtime.GOOS) + " && " + command
+}
+
+// quotePath quotes a path for the shell when it contains anything but safe characters.
+// Windows shells take double quotes; POSIX shells take single quotes, which disable every expansion.
+func quotePath(path, goos string) string {
+	safe := strings.IndexFunc(path, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("/\\:._-~", r))
+	}) == -1
+	if safe && path != "" {
+		return path
+	}
+	if goos == "windows" {
+		return `"` + path + `"`
+	}
+	return "'" + strings.ReplaceAll(path, "'", `'\''`) + "'"
+}
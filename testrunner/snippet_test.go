@@ -0,0 +1,119 @@
+package testrunner
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// useComposeCommand makes snippets see compose as installed in the given form
+func useComposeCommand(t *testing.T, compose []string, err error) {
+	t.Helper()
+	original := snippetComposeDetect
+	snippetComposeDetect = func() ([]string, error) { return compose, err }
+	t.Cleanup(func() { snippetComposeDetect = original })
+}
+
+func TestTestSnippet_ComposeProject(t *testing.T) {
+	// Arrange
+	useComposeCommand(t, composePluginCommand, nil)
+	projectDir := filepath.Join(t.TempDir(), "journal_api_p1")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatalf("Failed to create project dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(projectDir, composeFileName), []byte("services: {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to write compose file: %v", err)
+	}
+
+	// Act
+	snippet := TestSnippet(projectDir, "python", "tests")
+
+	// Assert
+	want := "cd " + projectDir + " && docker compose -f docker-compose.test.yml up --build --abort-on-container-exit --exit-code-from tests tests"
+	if snippet != want {
+		t.Errorf("Expected %q, got %q", want, snippet)
+	}
+}
+
+func TestTestCommand_InstalledComposeForm(t *testing.T) {
+	projectDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(projectDir, composeFileName), []byte("services: {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to write compose file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		compose []string
+		err     error
+		want    string
+	}{
+		{"plugin", composePluginCommand, nil, "docker compose -f"},
+		{"standalone", composeStandaloneCommand, nil, "docker-compose -f"},
+		{"none installed", nil, errors.New("Docker Compose not found"), "docker compose -f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			useComposeCommand(t, tt.compose, tt.err)
+
+			// Act
+			command := TestCommand(projectDir, "go", "")
+
+			// Assert
+			if !strings.HasPrefix(command, tt.want) {
+				t.Errorf("Expected the command to start with %q, got %q", tt.want, command)
+			}
+		})
+	}
+}
+
+func TestTestSnippet_LanguageCommandWithoutCompose(t *testing.T) {
+	tests := []struct {
+		language string
+		command  string
+	}{
+		{"go", "go test ./..."},
+		{"TypeScript", "npm test"},
+		{"kotlin", "./gradlew test"},
+		{"c#", "dotnet test"},
+		{"python", "pytest"},
+		{"cobol", ""},
+	}
+
+	projectDir := t.TempDir()
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			// Act
+			snippet := TestSnippet(projectDir, tt.language, "")
+
+			// Assert
+			want := ""
+			if tt.command != "" {
+				want = "cd " + projectDir + " && " + tt.command
+			}
+			if snippet != want {
+				t.Errorf("Expected %q, got %q", want, snippet)
+			}
+		})
+	}
+}
+
+func TestQuotePath(t *testing.T) {
+	tests := []struct {
+		path, goos, want string
+	}{
+		{"/home/ana/404skill_projects/journal_api_p1", "linux", "/home/ana/404skill_projects/journal_api_p1"},
+		{"/home/ana/my projects/journal", "darwin", "'/home/ana/my projects/journal'"},
+		{"/home/o'neil/journal", "linux", `'/home/o'\''neil/journal'`},
+		{`C:\Users\Ana Lee\journal`, "windows", `"C:\Users\Ana Lee\journal"`},
+	}
+
+	for _, tt := range tests {
+		if got := quotePath(tt.path, tt.goos); got != tt.want {
+			t.Errorf("quotePath(%q, %s) = %q, want %q", tt.path, tt.goos, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package testrunner
+
+import (
+	"404skill-cli/testreport"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// defaultSourceHashIgnore lists what is left out of a project's source hash wherever it appears:
+// version control, editor and dependency directories, the run logs and the compose file of the test
+// harness. None of them change what the project's own code does.
+var defaultSourceHashIgnore = []string{
+	".git", ".gradle", ".idea", ".vscode", ".venv", "venv", "__pycache__", ".pytest_cache",
+	"node_modules", "test-logs", composeFileName,
+}
+
+// rootBuildOutputIgnore lists build output directories, left out of the hash only at the project
+// root: deeper down the same names are often sources, e.g. a Go package named build or a cmd/bin.
+var rootBuildOutputIgnore = []string{"build", "dist", "out", "target", "bin", "obj"}
+
+// SourceHash fingerprints the project's sources, so an unchanged project's last results can be reused
+func (r *DefaultTestRunner) SourceHash(project Project) (string, error) {
+	projectDir, err := r.findProjectDirectory(project)
+	if err != nil {
+		return "", fmt.Errorf("failed to find project directory: %w", err)
+	}
+	return hashProjectSources(projectDir, r.config.SourceHashIgnore)
+}
+
+// UnchangedRun returns the project's saved run if it tested the sources as they are now, nil when
+// there is none, it predates source hashing, or the sources changed since
+func UnchangedRun(hasher SourceHasher, resultsDir string, project Project) *testreport.StoredRun {
+	run, err := testreport.LoadLastRun(resultsDir, project.ID)
+	if err != nil || run == nil || run.Result == nil || run.SourceHash == "" {
+		return nil
+	}
+	hash, err := hasher.SourceHash(project)
+	if err != nil || hash != run.SourceHash {
+		return nil
+	}
+	return run
+}
+
+// hashProjectSources returns a hex SHA-256 over the path and contents of every file in dir, skipping
+// files and directories matched by the default ignore lists or an extra pattern. Patterns are globs
+// matched against both the base name and the slash-separated path relative to dir; build output
+// directories are only matched at the root of dir. The walk is in
+// lexical order, so the hash only changes when a file is added, removed, renamed or edited.
+func hashProjectSources(dir string, ignore []string) (string, error) {
+	patterns := append(append([]string{}, defaultSourceHashIgnore...), ignore...)
+	hash := sha256.New()
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if ignoredSource(rel, entry.Name(), patterns) || (entry.IsDir() && ignoredSource(rel, rel, rootBuildOutputIgnore)) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		sum, err := hashFile(path)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(hash, "%s\x00%s\n", rel, sum)
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to hash project sources: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// hashFile returns the hex SHA-256 of a file's contents
+func hashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ignoredSource reports whether a file or directory matches any ignore pattern
+func ignoredSource(rel, name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,190 @@
+package testrunner
+
+import (
+	"404skill-cli/testreport"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeSourceFiles creates files relative to dir, making parent directories as needed
+func writeSourceFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create dir for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+}
+
+func mustHash(t *testing.T, dir string, ignore []string) string {
+	t.Helper()
+	hash, err := hashProjectSources(dir, ignore)
+	if err != nil {
+		t.Fatalf("Failed to hash sources: %v", err)
+	}
+	return hash
+}
+
+func TestHashProjectSources_IgnoresBuildOutputAndHarness(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writeSourceFiles(t, dir, map[string]string{"main.go": "package main\n", "pkg/util.go": "package pkg\n"})
+	before := mustHash(t, dir, nil)
+
+	// Act
+	writeSourceFiles(t, dir, map[string]string{
+		"node_modules/left-pad/index.js": "module.exports = 1\n",
+		"build/output.bin":               "binary",
+		"test-logs/run.log":              "log",
+		composeFileName:                  "services: {}\n",
+		".git/HEAD":                      "ref: refs/heads/main\n",
+	})
+	after := mustHash(t, dir, nil)
+
+	// Assert
+	if before != after {
+		t.Error("Expected ignored files not to change the hash")
+	}
+}
+
+func TestHashProjectSources_NestedBuildOutputNamesAreSources(t *testing.T) {
+	// Arrange - only the root's bin is build output, internal/build and cmd/bin are packages
+	dir := t.TempDir()
+	writeSourceFiles(t, dir, map[string]string{
+		"main.go":                 "package main\n",
+		"internal/build/build.go": "package build\n",
+		"cmd/bin/main.go":         "package main\n",
+		"bin/app":                 "binary",
+	})
+	before := mustHash(t, dir, nil)
+
+	for _, name := range []string{"internal/build/build.go", "cmd/bin/main.go"} {
+		// Act
+		writeSourceFiles(t, dir, map[string]string{name: "package changed\n"})
+		after := mustHash(t, dir, nil)
+
+		// Assert
+		if before == after {
+			t.Errorf("Expected editing %s to change the hash", name)
+		}
+		before = after
+	}
+}
+
+func TestHashProjectSources_ConfiguredPatterns(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writeSourceFiles(t, dir, map[string]string{"main.go": "package main\n"})
+	ignore := []string{"*.tmp", "docs/*"}
+	before := mustHash(t, dir, ignore)
+
+	// Act
+	writeSourceFiles(t, dir, map[string]string{"scratch.tmp": "x", "docs/notes.md": "# notes\n"})
+	after := mustHash(t, dir, ignore)
+
+	// Assert
+	if before != after {
+		t.Error("Expected files matching the configured patterns not to change the hash")
+	}
+}
+
+func TestHashProjectSources_DetectsChanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, dir string)
+	}{
+		{"edit", func(t *testing.T, dir string) {
+			writeSourceFiles(t, dir, map[string]string{"main.go": "package main\n\nfunc main() {}\n"})
+		}},
+		{"add", func(t *testing.T, dir string) {
+			writeSourceFiles(t, dir, map[string]string{"pkg/new.go": "package pkg\n"})
+		}},
+		{"rename", func(t *testing.T, dir string) {
+			if err := os.Rename(filepath.Join(dir, "pkg", "util.go"), filepath.Join(dir, "pkg", "helpers.go")); err != nil {
+				t.Fatalf("Failed to rename: %v", err)
+			}
+		}},
+		{"remove", func(t *testing.T, dir string) {
+			if err := os.Remove(filepath.Join(dir, "pkg", "util.go")); err != nil {
+				t.Fatalf("Failed to remove: %v", err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			dir := t.TempDir()
+			writeSourceFiles(t, dir, map[string]string{"main.go": "package main\n", "pkg/util.go": "package pkg\n"})
+			before := mustHash(t, dir, nil)
+
+			// Act
+			tt.change(t, dir)
+			after := mustHash(t, dir, nil)
+
+			// Assert
+			if before == after {
+				t.Errorf("Expected the hash to change after %s", tt.name)
+			}
+		})
+	}
+}
+
+type stubSourceHasher struct {
+	hash string
+}
+
+func (s stubSourceHasher) SourceHash(Project) (string, error) {
+	return s.hash, nil
+}
+
+func TestUnchangedRun(t *testing.T) {
+	project := Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}}
+
+	tests := []struct {
+		name      string
+		savedHash string
+		current   string
+		wantRun   bool
+	}{
+		{"sources unchanged", "abc", "abc", true},
+		{"sources changed", "abc", "def", false},
+		{"run predates hashing", "", "abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			resultsDir := t.TempDir()
+			run := testreport.StoredRun{ProjectID: project.ID, RanAt: time.Now(), Result: result, SourceHash: tt.savedHash}
+			if err := testreport.SaveLastRun(resultsDir, run); err != nil {
+				t.Fatalf("Failed to save run: %v", err)
+			}
+
+			// Act
+			got := UnchangedRun(stubSourceHasher{hash: tt.current}, resultsDir, project)
+
+			// Assert
+			if (got != nil) != tt.wantRun {
+				t.Errorf("Expected a saved run: %v, got %+v", tt.wantRun, got)
+			}
+		})
+	}
+}
+
+func TestUnchangedRun_NoSavedRun(t *testing.T) {
+	// Act
+	got := UnchangedRun(stubSourceHasher{hash: "abc"}, t.TempDir(), Project{ID: "p1"})
+
+	// Assert
+	if got != nil {
+		t.Errorf("Expected no run without a saved one, got %+v", got)
+	}
+}
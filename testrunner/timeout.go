@@ -0,0 +1,61 @@
+package testrunner
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultRunTimeout bounds a compose run whose test container never exits
+const DefaultRunTimeout = 30 * time.Minute
+
+// ErrRunTimedOut is returned when a compose run is stopped for exceeding the run timeout
+var ErrRunTimedOut = errors.New("test run timed out")
+
+// noExitHint explains the usual cause of a timeout when no container ever exited
+const noExitHint = "No test container exited — check your compose setup runs the tests as a container that exits."
+
+// composeWatch follows the output of a compose run for what it says about the tests.
+// Lines arrive from the stdout and stderr readers concurrently.
+type composeWatch struct {
+	mu              sync.Mutex
+	testsExecuted   bool
+	testsUpToDate   bool
+	containerExited bool
+}
+
+// observe records what a line of compose output shows
+func (w *composeWatch) observe(line string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	// With --abort-on-container-exit, compose reports the container that ended the run
+	if strings.Contains(line, "exited with code") {
+		w.containerExited = true
+	}
+	if strings.Contains(line, "> Task :test") {
+		if strings.Contains(line, "UP-TO-DATE") {
+			w.testsUpToDate = true
+		} else {
+			w.testsExecuted = true
+		}
+	}
+}
+
+// state returns what the output has shown so far
+func (w *composeWatch) state() (testsExecuted, testsUpToDate, containerExited bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.testsExecuted, w.testsUpToDate, w.containerExited
+}
+
+// timeoutError describes a run stopped after timeout, pointing at the compose setup when no
+// container exited, since that is why --abort-on-container-exit never ended the run
+func (w *composeWatch) timeoutError(timeout time.Duration) error {
+	if _, _, exited := w.state(); !exited {
+		return fmt.Errorf("%w after %s. %s", ErrRunTimedOut, timeout, noExitHint)
+	}
+	return fmt.Errorf("%w after %s", ErrRunTimedOut, timeout)
+}
@@ -0,0 +1,88 @@
+package testrunner
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComposeWatch_TimeoutWithoutExitedContainer(t *testing.T) {
+	// Arrange - long-running services that never exit
+	watch := &composeWatch{}
+	for _, line := range []string{
+		"Container sample-db-1  Started",
+		"db-1   | database system is ready to accept connections",
+		"api-1  | listening on :8080",
+	} {
+		watch.observe(line)
+	}
+
+	// Act
+	err := watch.timeoutError(time.Minute)
+
+	// Assert
+	if !errors.Is(err, ErrRunTimedOut) {
+		t.Errorf("Expected a timeout error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), noExitHint) {
+		t.Errorf("Expected the compose setup hint, got: %v", err)
+	}
+}
+
+func TestComposeWatch_TimeoutAfterContainerExited(t *testing.T) {
+	// Arrange
+	watch := &composeWatch{}
+	watch.observe("tests-1 exited with code 0")
+
+	// Act
+	err := watch.timeoutError(time.Minute)
+
+	// Assert
+	if !errors.Is(err, ErrRunTimedOut) {
+		t.Errorf("Expected a timeout error, got: %v", err)
+	}
+	if strings.Contains(err.Error(), noExitHint) {
+		t.Errorf("Expected no compose setup hint once a container exited, got: %v", err)
+	}
+}
+
+func TestComposeWatch_TracksGradleTestTask(t *testing.T) {
+	watch := &composeWatch{}
+	watch.observe("tests-1  | > Task :test UP-TO-DATE")
+
+	executed, upToDate, exited := watch.state()
+
+	if executed || !upToDate || exited {
+		t.Errorf("Expected only up-to-date tests, got executed=%t upToDate=%t exited=%t", executed, upToDate, exited)
+	}
+}
+
+func TestDefaultTestRunner_RunTests_TimesOutWhenNoContainerExits(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell as the compose command")
+	}
+
+	// Arrange - a "compose" that starts a service and never exits
+	runner, project, _ := newValidationRunner(t)
+	runner.config.RunTimeout = 200 * time.Millisecond
+	runner.composeDetect = func() ([]string, error) {
+		return []string{"sh", "-c", "echo 'db-1 | ready'; exec sleep 30", "compose"}, nil
+	}
+
+	// Act
+	start := time.Now()
+	_, err := runner.RunTests(project, nil)
+
+	// Assert
+	if !errors.Is(err, ErrRunTimedOut) {
+		t.Fatalf("Expected the run to time out, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), noExitHint) {
+		t.Errorf("Expected the compose setup hint, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Expected the run to stop at the timeout, took %s", elapsed)
+	}
+}
@@ -2,6 +2,7 @@ package testrunner
 
 import (
 	"404skill-cli/testreport"
+	"time"
 )
 
 // TestRunner interface for running tests on projects
@@ -15,3 +16,96 @@ type Project struct {
 	Name     string
 	Language string
 }
+
+// Validator checks that a project can be tested without running any tests
+type Validator interface {
+	Validate(project Project) *ValidationReport
+}
+
+// LogLocator is implemented by runners that keep a log of each test run
+type LogLocator interface {
+	LatestLogPath(project Project) (string, error)
+}
+
+// SingleTestRunner is implemented by runners that can re-run one test from a previous run
+type SingleTestRunner interface {
+	RunTest(project Project, test testreport.TestResult, progressCallback func(string)) (*testreport.TestResult, error)
+}
+
+// FastRerunner is implemented by runners that can skip rebuilding the test image
+type FastRerunner interface {
+	SetFastRerun(enabled bool)
+	FastRerun() bool
+}
+
+// DebugReporter is implemented by runners that can run tests with maximum diagnostics
+type DebugReporter interface {
+	DebugTests() bool
+}
+
+// UsageReporter is implemented by runners that can report the resource usage of a project's last run
+type UsageReporter interface {
+	LastUsage(project Project) (ResourceUsage, bool)
+}
+
+// ReportWatcher is implemented by runners that can read a project's newest report outside of a run,
+// e.g. one written by tests the user ran in their own terminal
+type ReportWatcher interface {
+	LatestReportTime(project Project) (time.Time, error)
+	LoadLatestReport(project Project) (*testreport.ParseResult, error)
+}
+
+// SourceHasher is implemented by runners that can fingerprint a project's sources, so the last
+// results of a project that hasn't changed since can be shown instead of running it again
+type SourceHasher interface {
+	SourceHash(project Project) (string, error)
+}
+
+// ReportFormatCache remembers which test report format each project writes, so it is detected only once
+type ReportFormatCache interface {
+	GetReportFormat(projectID string) string
+	SetReportFormat(projectID, format string) error
+}
+
+// TestServiceSource names the compose service that runs each project's tests; "" runs every service
+type TestServiceSource interface {
+	GetTestService(projectID string) string
+}
+
+// ValidationCheck is the outcome of a single pre-flight check
+type ValidationCheck struct {
+	Name   string
+	Passed bool
+	Detail string
+}
+
+// ValidationReport collects the pre-flight checks run for a project
+type ValidationReport struct {
+	Project Project
+	Checks  []ValidationCheck
+}
+
+// OK returns true if every check passed
+func (r *ValidationReport) OK() bool {
+	return len(r.Failed()) == 0
+}
+
+// Failed returns the checks that did not pass
+func (r *ValidationReport) Failed() []ValidationCheck {
+	var failed []ValidationCheck
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// add records a check, using the error message as detail when it failed
+func (r *ValidationReport) add(name string, err error, detail string) {
+	check := ValidationCheck{Name: name, Passed: err == nil, Detail: detail}
+	if err != nil {
+		check.Detail = err.Error()
+	}
+	r.Checks = append(r.Checks, check)
+}
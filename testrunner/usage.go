@@ -0,0 +1,212 @@
+package testrunner
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// statsInterval is how often the containers are sampled during a run; docker stats itself
+// takes about a second to answer, so sampling more often gains nothing
+const statsInterval = 2 * time.Second
+
+// ContainerStats is one container's resource use at a point in time
+type ContainerStats struct {
+	MemoryBytes uint64
+	CPUPercent  float64 // percent of one core, so it can exceed 100 on several cores
+}
+
+// ResourceUsage summarises how long a test run took and what its containers used at their peak
+type ResourceUsage struct {
+	Duration   time.Duration
+	PeakMemory uint64  // bytes across all containers
+	PeakCPU    float64 // percent of one core across all containers
+	Sampled    bool    // false when docker stats could not be read, so only Duration is known
+}
+
+// Summary describes the usage in one line, e.g. "Tests ran in 2m14s, peak 1.2GB RAM, 180% CPU"
+func (u ResourceUsage) Summary() string {
+	summary := "Tests ran in " + formatRunDuration(u.Duration)
+	if !u.Sampled {
+		return summary
+	}
+	return fmt.Sprintf("%s, peak %s RAM, %.0f%% CPU", summary, formatBytes(u.PeakMemory), u.PeakCPU)
+}
+
+// summarizeUsage computes a run's usage from its start and end and the stats sampled in
+// between. Each sample holds every container running at the time, so the peak is the
+// largest total of any one sample rather than the sum of each container's own peak.
+func summarizeUsage(start, end time.Time, samples [][]ContainerStats) ResourceUsage {
+	usage := ResourceUsage{Duration: end.Sub(start)}
+	if usage.Duration < 0 {
+		usage.Duration = 0
+	}
+	for _, sample := range samples {
+		var memory uint64
+		var cpu float64
+		for _, container := range sample {
+			memory += container.MemoryBytes
+			cpu += container.CPUPercent
+		}
+		usage.PeakMemory = max(usage.PeakMemory, memory)
+		usage.PeakCPU = max(usage.PeakCPU, cpu)
+		usage.Sampled = true
+	}
+	return usage
+}
+
+// formatRunDuration rounds to the second, or to the millisecond for runs under a second
+func formatRunDuration(d time.Duration) string {
+	if d < time.Second {
+		return d.Round(time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+// formatBytes prints a byte count with one decimal in the largest fitting unit
+func formatBytes(b uint64) string {
+	units := []string{"KB", "MB", "GB", "TB"}
+	if b < 1000 {
+		return fmt.Sprintf("%dB", b)
+	}
+	value := float64(b)
+	unit := ""
+	for _, u := range units {
+		value /= 1000
+		unit = u
+		if value < 1000 {
+			break
+		}
+	}
+	return fmt.Sprintf("%.1f%s", value, unit)
+}
+
+// usageSampler collects container stats in the background until stopped. It gives up on the
+// first error, since stats that fail once (no daemon support, a rootless engine) keep failing.
+type usageSampler struct {
+	mu      sync.Mutex
+	samples [][]ContainerStats
+	stopCh  chan struct{}
+	done    chan struct{}
+}
+
+// startUsageSampler calls sample every interval until stop is called
+func startUsageSampler(sample func() ([]ContainerStats, error), interval time.Duration) *usageSampler {
+	s := &usageSampler{stopCh: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stopCh:
+				return
+			case <-ticker.C:
+				stats, err := sample()
+				if err != nil {
+					return
+				}
+				if len(stats) > 0 {
+					s.mu.Lock()
+					s.samples = append(s.samples, stats)
+					s.mu.Unlock()
+				}
+			}
+		}
+	}()
+	return s
+}
+
+// stop ends sampling and returns the samples taken
+func (s *usageSampler) stop() [][]ContainerStats {
+	close(s.stopCh)
+	<-s.done
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.samples
+}
+
+// composeStats samples the running containers of the project's compose setup
+func composeStats(compose []string, projectDir string) ([]ContainerStats, error) {
+	ps := newComposeCmd(compose, "-f", composeFileName, "ps", "-q")
+	ps.Dir = projectDir
+	output, err := ps.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list containers: %w", err)
+	}
+	ids := strings.Fields(string(output))
+	if len(ids) == 0 {
+		// Nothing running yet, e.g. while the image builds
+		return nil, nil
+	}
+
+	args := append([]string{"stats", "--no-stream", "--format", "{{.MemUsage}}\t{{.CPUPerc}}"}, ids...)
+	output, err = exec.Command("docker", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read docker stats: %w", err)
+	}
+	return parseStats(output)
+}
+
+// parseStats reads docker stats lines of the form "512MiB / 7.6GiB\t12.5%"
+func parseStats(output []byte) ([]ContainerStats, error) {
+	var stats []ContainerStats
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		memUsage, cpuPerc, ok := strings.Cut(line, "\t")
+		if !ok {
+			return nil, fmt.Errorf("unexpected docker stats line: %q", line)
+		}
+		memory, err := parseMemory(memUsage)
+		if err != nil {
+			return nil, err
+		}
+		cpu, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(cpuPerc), "%"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU usage %q: %w", cpuPerc, err)
+		}
+		stats = append(stats, ContainerStats{MemoryBytes: memory, CPUPercent: cpu})
+	}
+	return stats, scanner.Err()
+}
+
+// memoryUnits maps the units docker prints for memory to their size in bytes
+var memoryUnits = map[string]float64{
+	"B":   1,
+	"kB":  1e3,
+	"KB":  1e3,
+	"MB":  1e6,
+	"GB":  1e9,
+	"TB":  1e12,
+	"KiB": 1 << 10,
+	"MiB": 1 << 20,
+	"GiB": 1 << 30,
+	"TiB": 1 << 40,
+}
+
+// parseMemory reads the used half of a docker MemUsage value such as "1.2GiB / 7.6GiB"
+func parseMemory(memUsage string) (uint64, error) {
+	used, _, _ := strings.Cut(memUsage, "/")
+	used = strings.TrimSpace(used)
+	number := strings.TrimRightFunc(used, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	multiplier, ok := memoryUnits[strings.TrimSpace(used[len(number):])]
+	if !ok {
+		return 0, fmt.Errorf("invalid memory usage %q", memUsage)
+	}
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory usage %q: %w", memUsage, err)
+	}
+	return uint64(value * multiplier), nil
+}
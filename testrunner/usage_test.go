@@ -0,0 +1,126 @@
+package testrunner
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSummarizeUsage_DurationAndPeaks(t *testing.T) {
+	// Arrange
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(2*time.Minute + 14*time.Second + 300*time.Millisecond)
+	samples := [][]ContainerStats{
+		{{MemoryBytes: 400_000_000, CPUPercent: 50}},
+		{{MemoryBytes: 900_000_000, CPUPercent: 120}, {MemoryBytes: 300_000_000, CPUPercent: 30}},
+		{{MemoryBytes: 1_000_000_000, CPUPercent: 10}},
+	}
+
+	// Act
+	usage := summarizeUsage(start, end, samples)
+
+	// Assert
+	if usage.Duration != 2*time.Minute+14*time.Second+300*time.Millisecond {
+		t.Errorf("Expected the run's wall time, got %s", usage.Duration)
+	}
+	if usage.PeakMemory != 1_200_000_000 {
+		t.Errorf("Expected the largest sample total of 1.2GB, got %d", usage.PeakMemory)
+	}
+	if usage.PeakCPU != 150 {
+		t.Errorf("Expected peak CPU of 150%%, got %v", usage.PeakCPU)
+	}
+	if got := usage.Summary(); got != "Tests ran in 2m14s, peak 1.2GB RAM, 150% CPU" {
+		t.Errorf("Unexpected summary: %q", got)
+	}
+}
+
+func TestSummarizeUsage_StatsUnavailable(t *testing.T) {
+	// Arrange
+	start := time.Now()
+
+	// Act
+	usage := summarizeUsage(start, start.Add(850*time.Millisecond), nil)
+
+	// Assert
+	if usage.Sampled {
+		t.Error("Expected usage without samples to be marked unsampled")
+	}
+	if got := usage.Summary(); got != "Tests ran in 850ms" {
+		t.Errorf("Expected only the duration, got %q", got)
+	}
+}
+
+func TestParseStats(t *testing.T) {
+	// Arrange
+	output := []byte("512MiB / 7.6GiB\t12.5%\n1.5GB / 8GB\t101.25%\n\n")
+
+	// Act
+	stats, err := parseStats(output)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("Expected 2 containers, got %d", len(stats))
+	}
+	if stats[0].MemoryBytes != 512<<20 || stats[0].CPUPercent != 12.5 {
+		t.Errorf("Unexpected first container stats: %+v", stats[0])
+	}
+	if stats[1].MemoryBytes != 1_500_000_000 || stats[1].CPUPercent != 101.25 {
+		t.Errorf("Unexpected second container stats: %+v", stats[1])
+	}
+}
+
+func TestParseStats_Invalid(t *testing.T) {
+	for _, output := range []string{"512MiB / 7.6GiB", "lots / 7.6GiB\t1%", "512MiB / 7.6GiB\t--"} {
+		if _, err := parseStats([]byte(output)); err == nil {
+			t.Errorf("Expected an error for %q", output)
+		}
+	}
+}
+
+func TestUsageSampler_StopsOnError(t *testing.T) {
+	// Arrange
+	var calls atomic.Int32
+	sample := func() ([]ContainerStats, error) {
+		if calls.Add(1) == 1 {
+			return []ContainerStats{{MemoryBytes: 100}}, nil
+		}
+		return nil, errors.New("stats not supported")
+	}
+
+	// Act
+	sampler := startUsageSampler(sample, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	samples := sampler.stop()
+
+	// Assert
+	if calls.Load() != 2 {
+		t.Errorf("Expected sampling to stop after the first error, got %d calls", calls.Load())
+	}
+	if len(samples) != 1 {
+		t.Errorf("Expected the sample taken before the error to be kept, got %d", len(samples))
+	}
+}
+
+func TestDefaultTestRunner_LastUsage(t *testing.T) {
+	// Arrange
+	runner := NewDefaultTestRunner()
+	project := Project{ID: "p1"}
+
+	// Act
+	runner.recordUsage(project.ID, &ResourceUsage{Duration: time.Second})
+	recorded, recordedOK := runner.LastUsage(project)
+	runner.recordUsage(project.ID, nil)
+	_, clearedOK := runner.LastUsage(project)
+
+	// Assert
+	if !recordedOK || recorded.Duration != time.Second {
+		t.Errorf("Expected the recorded usage, got %+v (ok=%t)", recorded, recordedOK)
+	}
+	if clearedOK {
+		t.Error("Expected a run without stats to clear the previous usage")
+	}
+}
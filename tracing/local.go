@@ -9,6 +9,8 @@ import (
 	"sync"
 	"time"
 
+	"404skill-cli/homedir"
+
 	"github.com/google/uuid"
 )
 
@@ -23,6 +25,8 @@ type LocalTracer struct {
 	flushTicker *time.Ticker
 	stopChan    chan struct{}
 	wg          sync.WaitGroup
+	closeOnce   sync.Once
+	closeErr    error
 }
 
 // NewLocalTracer creates a new local file tracer with the given configuration
@@ -116,25 +120,30 @@ func (l *LocalTracer) Flush() error {
 	return l.flushUnsafe()
 }
 
-// Close gracefully shuts down the tracer and performs cleanup
+// Close gracefully shuts down the tracer and performs cleanup.
+// Background flushing is stopped before the final flush so no events are written after it; calling Close again is a no-op.
 func (l *LocalTracer) Close() error {
-	// Stop background flushing
-	if l.flushTicker != nil {
-		l.flushTicker.Stop()
-		close(l.stopChan)
-		l.wg.Wait()
-	}
+	l.closeOnce.Do(func() {
+		// Stop background flushing
+		if l.flushTicker != nil {
+			l.flushTicker.Stop()
+			close(l.stopChan)
+			l.wg.Wait()
+		}
 
-	// Final flush
-	if err := l.Flush(); err != nil {
-		return fmt.Errorf("failed to flush during close: %w", err)
-	}
+		// Final flush
+		if err := l.Flush(); err != nil {
+			l.closeErr = fmt.Errorf("failed to flush during close: %w", err)
+			return
+		}
 
-	// Update session end time
-	l.session.EndTime = time.Now()
+		// Update session end time
+		l.session.EndTime = time.Now()
 
-	// Clean up old sessions
-	return l.cleanupOldSessions()
+		// Clean up old sessions
+		l.closeErr = l.cleanupOldSessions()
+	})
+	return l.closeErr
 }
 
 // startBackgroundFlushing starts a goroutine that periodically flushes the buffer
@@ -255,7 +264,7 @@ func expandPath(path string) (string, error) {
 		return path, nil
 	}
 
-	home, err := os.UserHomeDir()
+	home, err := homedir.Dir()
 	if err != nil {
 		return "", err
 	}
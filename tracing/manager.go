@@ -220,10 +220,17 @@ func (m *Manager) Close() error {
 	event := NewNavigationEvent(m.sessionID, "session_active", "session_end", "application_exit")
 	_ = m.tracer.TrackNavigation(*event)
 
+	// Persist everything recorded so far before the tracer starts shutting down,
+	// so a failing upload or cleanup can't lose the final events
+	flushErr := m.tracer.Flush()
+
 	// Close the tracer
 	err := m.tracer.Close()
 	m.closed = true
 
+	if flushErr != nil {
+		return fmt.Errorf("failed to flush traces: %w", flushErr)
+	}
 	return err
 }
 
@@ -256,6 +263,9 @@ func (t *TimedOperationTracker) AddMetadata(key, value string) *TimedOperationTr
 
 // Complete marks the operation as completed successfully
 func (t *TimedOperationTracker) Complete() error {
+	if t.manager == nil {
+		return nil // tracing not initialized
+	}
 	duration := time.Since(t.startTime)
 	if t.metadata != nil {
 		return t.manager.TrackOperationWithContext(t.operation, duration, true, t.metadata)
@@ -265,6 +275,9 @@ func (t *TimedOperationTracker) Complete() error {
 
 // CompleteWithError marks the operation as completed with an error
 func (t *TimedOperationTracker) CompleteWithError(err error) error {
+	if t.manager == nil {
+		return nil // tracing not initialized
+	}
 	duration := time.Since(t.startTime)
 
 	// Track the performance (as failed)
@@ -321,6 +334,14 @@ func GetGlobalManager() *Manager {
 	return globalManager
 }
 
+// FlushGlobalTracing persists any pending events of the global tracing manager
+func FlushGlobalTracing() error {
+	if globalManager != nil {
+		return globalManager.Flush()
+	}
+	return nil
+}
+
 // CloseGlobalTracing closes the global tracing manager
 func CloseGlobalTracing() error {
 	if globalManager != nil {
@@ -50,11 +50,23 @@ func (kb KeyBinding) Format() string {
 
 // Common key bindings for reuse
 var (
-	QuitBinding     = KeyBinding{Key: "q", Description: "quit"}
-	BackBinding     = KeyBinding{Key: "esc/b", Description: "back"}
-	EnterBinding    = KeyBinding{Key: "enter", Description: "select"}
-	ConfirmBinding  = KeyBinding{Key: "enter", Description: "confirm"}
-	SubmitBinding   = KeyBinding{Key: "enter", Description: "submit"}
-	TabBinding      = KeyBinding{Key: "tab", Description: "switch"}
-	NavigateBinding = KeyBinding{Key: "↑/↓ or k/j", Description: "move"}
+	QuitBinding       = KeyBinding{Key: "q", Description: "quit"}
+	BackBinding       = KeyBinding{Key: "esc/b", Description: "back"}
+	EnterBinding      = KeyBinding{Key: "enter", Description: "select"}
+	ConfirmBinding    = KeyBinding{Key: "enter", Description: "confirm"}
+	SubmitBinding     = KeyBinding{Key: "enter", Description: "submit"}
+	TabBinding        = KeyBinding{Key: "tab", Description: "switch"}
+	NavigateBinding   = KeyBinding{Key: "↑/↓ or k/j", Description: "move"}
+	ValidateBinding   = KeyBinding{Key: "c", Description: "check setup"}
+	FastBinding       = KeyBinding{Key: "f", Description: "fast rerun"}
+	EditorBinding     = KeyBinding{Key: "e", Description: "open in editor"}
+	PageBinding       = KeyBinding{Key: "w", Description: "project page"}
+	SnippetBinding    = KeyBinding{Key: "y", Description: "copy test command"}
+	HistoryBinding    = KeyBinding{Key: "h", Description: "history"}
+	OpenAfterBinding  = KeyBinding{Key: "o", Description: "open when done"}
+	CopyErrorBinding  = KeyBinding{Key: "c", Description: "copy error"}
+	BundleBinding     = KeyBinding{Key: "B", Description: "support bundle"}
+	ColorsBinding     = KeyBinding{Key: "D", Description: "difficulty colours"}
+	DownloadedBinding = KeyBinding{Key: "d", Description: "downloaded only"}
+	SortBinding       = KeyBinding{Key: "s", Description: "sort"}
 )
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"404skill-cli/api"
+	"404skill-cli/tui/domain"
+	"404skill-cli/tui/styles"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -15,12 +17,19 @@ type ProjectStatusProvider interface {
 	GetProjectStatus(projectID string) string
 }
 
+// ProjectProgressProvider is optionally implemented by status providers to enable sorting by progress
+type ProjectProgressProvider interface {
+	GetProjectProgress(projectID string) int
+}
+
 // Component represents a reusable project table
 type Component struct {
 	table          btable.Model
-	projects       []api.Project
+	projects       []api.Project // in the order they were set
+	rows           []api.Project // in display order
 	statusProvider ProjectStatusProvider
 	focused        bool
+	sortMode       domain.CompletionSort
 }
 
 // New creates a new table component with default styling
@@ -70,7 +79,7 @@ func (c *Component) GetHighlightedProject() *api.Project {
 	}
 
 	if id, ok := selectedRow.Data["id"].(string); ok {
-		for _, p := range c.projects {
+		for _, p := range c.rows {
 			if p.ID == id {
 				return &p
 			}
@@ -91,11 +100,39 @@ func (c *Component) View() string {
 	return c.table.View()
 }
 
+// CycleSort switches to the next completion sort order, keeping the highlighted project selected
+func (c *Component) CycleSort() domain.CompletionSort {
+	c.sortMode = c.sortMode.Next()
+	c.refreshTable()
+	return c.sortMode
+}
+
+// SortMode returns the current sort order
+func (c *Component) SortMode() domain.CompletionSort {
+	return c.sortMode
+}
+
 // refreshTable rebuilds the table rows from current project data
 func (c *Component) refreshTable() {
+	var highlightedID string
+	if highlighted := c.GetHighlightedProject(); highlighted != nil {
+		highlightedID = highlighted.ID
+	}
+
+	var progress func(projectID string) int
+	if provider, ok := c.statusProvider.(ProjectProgressProvider); ok {
+		progress = provider.GetProjectProgress
+	}
+	c.rows = domain.SortByCompletion(c.projects, progress, c.sortMode)
+
 	var rows []btable.Row
+	highlightedIndex := 0
+
+	for i, p := range c.rows {
+		if p.ID == highlightedID {
+			highlightedIndex = i
+		}
 
-	for _, p := range c.projects {
 		status := ""
 		if c.statusProvider != nil {
 			status = c.statusProvider.GetProjectStatus(p.ID)
@@ -104,14 +141,14 @@ func (c *Component) refreshTable() {
 		rows = append(rows, btable.NewRow(map[string]interface{}{
 			"id":     p.ID,
 			"name":   p.Name,
-			"lang":   p.Language,
-			"diff":   p.Difficulty,
+			"lang":   styles.LanguageCell(p.Language),
+			"diff":   styles.DifficultyCell(p.Difficulty),
 			"dur":    fmt.Sprintf("%d min", p.EstimatedDurationInMinutes),
 			"status": status,
 		}))
 	}
 
-	c.table = c.table.WithRows(rows)
+	c.table = c.table.WithRows(rows).WithHighlightedRow(highlightedIndex)
 	if c.focused {
 		c.table = c.table.Focused(true)
 	}
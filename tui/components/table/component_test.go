@@ -241,8 +241,8 @@ func TestView_WithProjects(t *testing.T) {
 		"Test Project 2",
 		"Test Project 3",
 		"Go",
-		"Python",
-		"JavaScript",
+		"Py", // language badges
+		"JS",
 		"Easy",
 		"Medium",
 		"Hard",
@@ -370,3 +370,36 @@ func TestSetProjects_UpdateExisting(t *testing.T) {
 		t.Error("Expected table to contain newly added project")
 	}
 }
+
+// MockProgressStatusProvider also reports per-project progress
+type MockProgressStatusProvider struct {
+	MockProjectStatusProvider
+	progress map[string]int
+}
+
+func (m *MockProgressStatusProvider) GetProjectProgress(projectID string) int {
+	return m.progress[projectID]
+}
+
+func TestCycleSort_PreservesHighlightedProject(t *testing.T) {
+	// Arrange
+	provider := &MockProgressStatusProvider{progress: map[string]int{"project1": 3, "project2": 0, "project3": 1}}
+	component := New(provider)
+	component.SetProjects(createTestProjects())
+	component.SetFocused(true)
+	component.Update(tea.KeyMsg{Type: tea.KeyDown}) // highlight project2
+
+	// Act
+	mode := component.CycleSort()
+
+	// Assert
+	if mode.String() != "least complete first" {
+		t.Errorf("Expected least complete first, got %s", mode)
+	}
+	if first := component.rows[0].ID; first != "project2" {
+		t.Errorf("Expected the least complete project first, got %s", first)
+	}
+	if highlighted := component.GetHighlightedProject(); highlighted == nil || highlighted.ID != "project2" {
+		t.Errorf("Expected project2 to stay highlighted after sorting, got %v", highlighted)
+	}
+}
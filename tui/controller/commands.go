@@ -1,7 +1,15 @@
 package controller
 
 import (
+	"404skill-cli/api"
+	"404skill-cli/config"
+	"404skill-cli/downloader"
+	"404skill-cli/support"
+	"404skill-cli/tui/domain"
+	"404skill-cli/tui/history"
+	"404skill-cli/tui/state"
 	"context"
+	"path/filepath"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -21,8 +29,94 @@ type (
 
 	// VersionTickerMsg is sent periodically to check for updates
 	VersionTickerMsg struct{}
+
+	// IncompleteDownloadsMsg reports projects left behind by interrupted downloads
+	IncompleteDownloadsMsg struct {
+		Downloads []downloader.IncompleteDownload
+	}
+
+	// IncompleteDownloadsCleanedMsg is sent after partial downloads were removed
+	IncompleteDownloadsCleanedMsg struct {
+		Cleaned []string // directory names removed
+		Error   error
+	}
+
+	// AdoptCandidatesMsg is sent after the unrecorded checkouts were matched against the projects
+	// the API knows
+	AdoptCandidatesMsg struct {
+		Candidates []downloader.AdoptCandidate
+		Error      error
+	}
+
+	// DownloadsAdoptedMsg is sent after hand-cloned projects were marked as downloaded
+	DownloadsAdoptedMsg struct {
+		Adopted []string // directory names now recorded as downloaded
+		Error   error
+	}
+
+	// DaySummaryMsg carries today's totals across every project's run history
+	DaySummaryMsg struct {
+		Summary history.DaySummary
+	}
+
+	// AnnouncementMsg carries the platform announcement, nil or with an empty ID when there is none
+	AnnouncementMsg struct {
+		Announcement *config.CachedAnnouncement
+	}
+
+	// SupportBundleMsg is sent after a support bundle was written
+	SupportBundleMsg struct {
+		Path  string
+		Error error
+	}
+
+	// PendingInitsRetriedMsg is sent after queued project initializations were retried
+	PendingInitsRetriedMsg struct {
+		Succeeded int
+		Error     error
+	}
 )
 
+// fetchProjectsCmd fetches the projects for a menu, cancelling any fetch still in flight.
+// The fetch lives as long as the menu: leaving it calls stopFetch.
+func (c *Controller) fetchProjectsCmd(menu state.State) tea.Cmd {
+	c.stopFetch()
+	ctx, cancel := context.WithCancel(context.Background())
+	c.fetchID++
+	c.fetchState = menu
+	c.cancelFetch = cancel
+	return c.projectService.FetchProjects(ctx, c.fetchID)
+}
+
+// stopFetch cancels the projects fetch in flight, if any
+func (c *Controller) stopFetch() {
+	if c.cancelFetch != nil {
+		c.cancelFetch()
+		c.cancelFetch = nil
+	}
+}
+
+// acceptProjects reports whether a fetch result is still wanted: it answers the latest fetch,
+// which has not been cancelled, and the menu it was made for is still showing
+func (c *Controller) acceptProjects(requestID int) bool {
+	if c.cancelFetch == nil || requestID != c.fetchID || c.stateMachine.Current() != c.fetchState {
+		return false
+	}
+	c.stopFetch() // done, release the context
+	return true
+}
+
+// projectsRequestID returns the fetch a projects message answers
+func projectsRequestID(msg tea.Msg) (int, bool) {
+	switch msg := msg.(type) {
+	case domain.ProjectsLoadedMsg:
+		return msg.RequestID, true
+	case domain.ProjectsErrorMsg:
+		return msg.RequestID, true
+	}
+	return 0, false
+}
+
 // refreshTokenCmd attempts to refresh the authentication token
 func (c *Controller) refreshTokenCmd() tea.Cmd {
 	return func() tea.Msg {
@@ -49,3 +143,180 @@ func (c *Controller) versionTickerCmd() tea.Cmd {
 		return VersionTickerMsg{}
 	})
 }
+
+// retryPendingInitsCmd retries project initializations that failed after a successful download
+func (c *Controller) retryPendingInitsCmd() tea.Cmd {
+	retrier, ok := c.downloader.(downloader.InitRetrier)
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		succeeded, err := retrier.RetryPendingInitializations(ctx)
+		return PendingInitsRetriedMsg{Succeeded: succeeded, Error: err}
+	}
+}
+
+// checkIncompleteDownloadsCmd looks for downloads interrupted in a previous session
+func (c *Controller) checkIncompleteDownloadsCmd() tea.Cmd {
+	return func() tea.Msg {
+		projectsDir, err := c.configManager.GetProjectsDir()
+		if err != nil {
+			return IncompleteDownloadsMsg{}
+		}
+		downloads, err := downloader.FindIncompleteDownloads(projectsDir, c.configManager.GetDownloadedProjects())
+		if err != nil {
+			return IncompleteDownloadsMsg{}
+		}
+		return IncompleteDownloadsMsg{Downloads: downloads}
+	}
+}
+
+// cleanIncompleteDownloadsCmd removes the interrupted downloads among downloads so they can be
+// downloaded again. Unrecorded checkouts may be the user's own work and are never removed.
+func (c *Controller) cleanIncompleteDownloadsCmd(downloads []downloader.IncompleteDownload) tea.Cmd {
+	return func() tea.Msg {
+		projectsDir, err := c.configManager.GetProjectsDir()
+		if err != nil {
+			return IncompleteDownloadsCleanedMsg{Error: err}
+		}
+		var cleaned []string
+		for _, download := range interruptedDownloads(downloads) {
+			if err := downloader.CleanIncompleteDownload(projectsDir, download); err != nil {
+				return IncompleteDownloadsCleanedMsg{Cleaned: cleaned, Error: err}
+			}
+			cleaned = append(cleaned, download.DirName)
+		}
+		return IncompleteDownloadsCleanedMsg{Cleaned: cleaned}
+	}
+}
+
+// adoptCandidatesCmd looks up which unrecorded checkouts among downloads are named like a
+// project the API knows. Interrupted downloads are left out: they must be resumed or cleaned up.
+func (c *Controller) adoptCandidatesCmd(downloads []downloader.IncompleteDownload) tea.Cmd {
+	var unrecorded []downloader.IncompleteDownload
+	for _, download := range downloads {
+		if !download.HasMarker {
+			unrecorded = append(unrecorded, download)
+		}
+	}
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		projects, err := c.client.ListProjects(ctx)
+		if err != nil {
+			return AdoptCandidatesMsg{Error: err}
+		}
+		return AdoptCandidatesMsg{Candidates: downloader.MatchAdoptable(unrecorded, projects)}
+	}
+}
+
+// adoptDownloadCmd marks a checkout the user confirmed as downloaded
+func (c *Controller) adoptDownloadCmd(candidate downloader.AdoptCandidate) tea.Cmd {
+	return func() tea.Msg {
+		projectsDir, err := c.configManager.GetProjectsDir()
+		if err != nil {
+			return DownloadsAdoptedMsg{Error: err}
+		}
+		if _, err := downloader.AdoptProject(c.configManager, projectsDir, candidate.DirName, []api.Project{candidate.Project}); err != nil {
+			return DownloadsAdoptedMsg{Error: err}
+		}
+		return DownloadsAdoptedMsg{Adopted: []string{candidate.DirName}}
+	}
+}
+
+// daySummaryCmd totals today's test runs from the persisted run history
+func (c *Controller) daySummaryCmd() tea.Cmd {
+	return func() tea.Msg {
+		return DaySummaryMsg{Summary: history.SummarizeDay(c.configManager.GetAllRunHistory(), time.Now())}
+	}
+}
+
+// announcementCacheTTL is how long a fetched announcement is reused before asking the API again
+const announcementCacheTTL = 6 * time.Hour
+
+// announcementCmd returns the platform announcement, fetching it only when the cached one is stale.
+// The endpoint is optional: when it fails, whatever was cached is shown.
+func (c *Controller) announcementCmd() tea.Cmd {
+	return func() tea.Msg {
+		cached := c.configManager.GetCachedAnnouncement()
+		if cached != nil && time.Since(cached.FetchedAt) < announcementCacheTTL {
+			return AnnouncementMsg{Announcement: cached}
+		}
+		source, ok := c.client.(api.AnnouncementSource)
+		if !ok {
+			return AnnouncementMsg{Announcement: cached}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		announcement, err := source.GetAnnouncement(ctx)
+		if err != nil {
+			return AnnouncementMsg{Announcement: cached}
+		}
+
+		fetched := config.CachedAnnouncement{FetchedAt: time.Now()}
+		if announcement != nil {
+			fetched.ID = announcement.ID
+			fetched.Message = announcement.Message
+		}
+		_ = c.configManager.SetCachedAnnouncement(fetched)
+		return AnnouncementMsg{Announcement: &fetched}
+	}
+}
+
+// createSupportBundleCmd writes a redacted support bundle next to the config file and reveals it
+func (c *Controller) createSupportBundleCmd() tea.Cmd {
+	return func() tea.Msg {
+		homeDir := filepath.Dir(config.ConfigFilePath)
+		opts := support.BundleOptions{
+			Version:   c.versionInfo.CurrentVersion,
+			SessionID: support.SessionID(),
+			Config:    c.configManager.EffectiveConfig(),
+			Secrets:   c.configManager.Secrets(),
+			TracesDir: filepath.Join(homeDir, "traces"),
+			Now:       time.Now(),
+		}
+		if projectsDir, err := c.configManager.GetProjectsDir(); err == nil {
+			opts.LogPath = support.LatestTestLog(projectsDir)
+		}
+
+		path, err := support.WriteBundle(homeDir, opts)
+		if err != nil {
+			return SupportBundleMsg{Error: err}
+		}
+		_ = c.fileManager.OpenFileExplorer(homeDir)
+		return SupportBundleMsg{Path: path}
+	}
+}
+
+// interruptedDownloads returns the downloads whose in-progress marker was found
+func interruptedDownloads(downloads []downloader.IncompleteDownload) []downloader.IncompleteDownload {
+	var interrupted []downloader.IncompleteDownload
+	for _, download := range downloads {
+		if download.HasMarker {
+			interrupted = append(interrupted, download)
+		}
+	}
+	return interrupted
+}
+
+// withoutDirs drops the downloads whose directories are listed
+func withoutDirs(downloads []downloader.IncompleteDownload, dirNames []string) []downloader.IncompleteDownload {
+	if len(dirNames) == 0 {
+		return downloads
+	}
+	drop := make(map[string]bool, len(dirNames))
+	for _, name := range dirNames {
+		drop[name] = true
+	}
+	var kept []downloader.IncompleteDownload
+	for _, download := range downloads {
+		if !drop[download.DirName] {
+			kept = append(kept, download)
+		}
+	}
+	return kept
+}
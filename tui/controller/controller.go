@@ -7,12 +7,15 @@ import (
 	"404skill-cli/downloader"
 	"404skill-cli/filesystem"
 	"404skill-cli/supabase"
+	"404skill-cli/support"
 	"404skill-cli/testreport"
 	"404skill-cli/testrunner"
 	"404skill-cli/tracing"
 	"404skill-cli/tui/components/footer"
 	"404skill-cli/tui/components/menu"
 	"404skill-cli/tui/domain"
+	"404skill-cli/tui/failing"
+	"404skill-cli/tui/history"
 	"404skill-cli/tui/keys"
 	"404skill-cli/tui/language"
 	"404skill-cli/tui/login"
@@ -20,7 +23,11 @@ import (
 	"404skill-cli/tui/state"
 	"404skill-cli/tui/test"
 	"404skill-cli/tui/variant"
+	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	tea "github.com/charmbracelet/bubbletea"
@@ -33,6 +40,7 @@ type MainMenuAction int
 const (
 	DownloadProject MainMenuAction = iota
 	TestProject
+	FailingTests
 )
 
 // Controller manages the overall TUI state and coordinates between components
@@ -57,6 +65,7 @@ type Controller struct {
 	testProjectNameMenu  *menu.Component
 	variantComponent     *variant.Component
 	testVariantComponent *variant.Component
+	failingComponent     *failing.Component // set while the failing tests overview is open
 	footer               *footer.Component
 	help                 help.Model
 
@@ -68,7 +77,7 @@ type Controller struct {
 	testRunner     testrunner.TestRunner
 	projectService *domain.ProjectService
 	projectUtils   *domain.ProjectUtils
-	versionChecker *VersionChecker
+	versionChecker UpdateChecker
 
 	// Application state
 	projects            []api.Project
@@ -76,15 +85,44 @@ type Controller struct {
 	selectedAction      MainMenuAction
 	loading             bool
 	errorMsg            string
+	statusMsg           string
 	quitting            bool
 	versionInfo         VersionInfo
+	incompleteDownloads []downloader.IncompleteDownload // left behind by an interrupted session
+	adoptQueue          []downloader.AdoptCandidate     // unrecorded checkouts waiting for the user to confirm them
+	daySummary          history.DaySummary              // today's runs, shown under every screen
+	announcement        *config.CachedAnnouncement      // shown above the main menu until dismissed, nil when there is none
+	width               int                             // terminal width from the last resize, for components created later
+	fetchID             int                             // identifies the latest projects fetch
+	fetchState          state.State                     // the menu the latest projects fetch is for
+	cancelFetch         context.CancelFunc              // cancels the projects fetch in flight, nil when none is
+	downloadedOnly      bool                            // the download menu lists only downloaded projects
+	nameMenuSort        domain.CompletionSort           // order of the download menu's projects
 
 	// Legacy table support (to be removed)
 	table btable.Model
 }
 
+// Dependencies holds the collaborators the controller is built from.
+// Any field left nil is replaced with the production implementation, so tests
+// can swap out only the pieces that would touch the network, git or docker.
+type Dependencies struct {
+	AuthProvider   auth.AuthProvider
+	FileManager    *filesystem.Manager
+	ConfigManager  *config.ConfigManager
+	Downloader     downloader.Downloader
+	TestRunner     testrunner.TestRunner
+	VersionChecker UpdateChecker
+	SkipLogin      bool // start at the main menu without credentials, as demo mode does
+}
+
 // New creates a new TUI controller
 func New(client api.ClientInterface, version string, tracer *tracing.TUIIntegration) (*Controller, error) {
+	return NewWithDependencies(client, version, tracer, Dependencies{})
+}
+
+// NewWithDependencies creates a new TUI controller using the given dependencies
+func NewWithDependencies(client api.ClientInterface, version string, tracer *tracing.TUIIntegration, deps Dependencies) (*Controller, error) {
 	// Track controller initialization
 	var initTracker *tracing.TimedOperationTracker
 	if tracer != nil {
@@ -92,31 +130,51 @@ func New(client api.ClientInterface, version string, tracer *tracing.TUIIntegrat
 	}
 
 	// Initialize dependencies
-	fileManager := filesystem.NewManager()
+	fileManager := deps.FileManager
+	if fileManager == nil {
+		fileManager = filesystem.NewManager()
+	}
 
 	// Create auth provider for dependency injection
-	supabaseClient, err := supabase.NewSupabaseClient()
-	if err != nil {
-		if tracer != nil {
-			_ = tracer.TrackError(err, "controller", "supabase_client_creation")
+	authProvider := deps.AuthProvider
+	if authProvider == nil {
+		// Login shares the API's proxy and CA settings; fall back to the library default if they are invalid
+		httpClient, err := api.NewHTTPClient(api.ClientOptionsFromConfig(config.NewConfigManager(nil)))
+		if err != nil {
+			if tracer != nil {
+				_ = tracer.TrackError(err, "controller", "http_client_creation")
+			}
+			httpClient = nil
+		}
+		supabaseClient, err := supabase.NewSupabaseClientWithHTTPClient(httpClient)
+		if err != nil {
+			if tracer != nil {
+				_ = tracer.TrackError(err, "controller", "supabase_client_creation")
+			}
+			// Handle error appropriately - for now we'll continue with nil
+			// In production, you might want to handle this differently
 		}
-		// Handle error appropriately - for now we'll continue with nil
-		// In production, you might want to handle this differently
+		authProvider = auth.NewSupabaseAuth(supabaseClient)
 	}
-	authProvider := auth.NewSupabaseAuth(supabaseClient)
 
-	// Create a basic config writer that doesn't depend on auth service
-	configWriter := config.SimpleConfigWriter{}
+	// Create config manager with auth service dependency
+	configManager := deps.ConfigManager
+	if configManager == nil {
+		// Create a basic config writer that doesn't depend on auth service
+		configWriter := config.SimpleConfigWriter{}
 
-	// Create auth service with dependencies
-	authService := auth.NewAuthService(authProvider, &configWriter)
+		// Create auth service with dependencies
+		authService := auth.NewAuthService(authProvider, &configWriter)
 
-	// Create config manager with auth service dependency
-	configManager := config.NewConfigManager(authService)
+		configManager = config.NewConfigManager(authService)
+	}
+	fileManager.SetEditor(configManager.GetEditor())
 
 	// Determine initial state
 	initialState := state.Login
-	if configManager.HasCredentials() {
+	if deps.SkipLogin {
+		initialState = state.MainMenu
+	} else if configManager.HasCredentials() {
 		initialState = state.RefreshingToken
 	}
 
@@ -136,23 +194,46 @@ func New(client api.ClientInterface, version string, tracer *tracing.TUIIntegrat
 	// Create components
 	loginComponent := login.New(authProvider, configManager)
 	projectComponent := projects.New(client, configManager, fileManager)
-	testRunner := testrunner.NewDefaultTestRunner()
+	testRunner := deps.TestRunner
+	if testRunner == nil {
+		runnerConfig := testrunner.DefaultRunnerConfig()
+		runnerConfig.BuildKit = configManager.IsBuildKitEnabled()
+		runnerConfig.FastRerun = configManager.IsFastRerunEnabled()
+		runnerConfig.DebugTests = configManager.IsDebugTestsEnabled()
+		runnerConfig.ResourceStats = configManager.IsResourceStatsEnabled()
+		runnerConfig.SourceHashIgnore = configManager.GetSourceHashIgnore()
+		if projectsDir, err := configManager.GetProjectsDir(); err == nil {
+			runnerConfig.ProjectsDir = projectsDir
+		}
+		defaultRunner := testrunner.NewDefaultTestRunnerWithConfig(runnerConfig)
+		defaultRunner.SetReportFormatCache(configManager)
+		defaultRunner.SetTestServiceSource(configManager)
+		testRunner = defaultRunner
+	}
 	testComponent := test.New(testRunner, configManager, client)
-	mainMenu := menu.New([]string{"Download a project", "Test a project"})
+	testComponent.SetSupportInfo(version, fileManager)
+	testComponent.SetURLOpener(fileManager)
+	mainMenu := menu.New([]string{"Download a project", "Test a project", "Failing tests"})
 	projectNameMenu := menu.New([]string{})
 	testProjectNameMenu := menu.New([]string{})
 	footer := footer.New()
 	help := help.New()
 
 	// Create downloader
-	gitDownloader := downloader.NewGitDownloader(fileManager, configManager, client)
+	projectDownloader := deps.Downloader
+	if projectDownloader == nil {
+		projectDownloader = downloader.NewGitDownloader(fileManager, configManager, client)
+	}
 
 	// Create domain services
 	projectService := domain.NewProjectService(client)
 	projectUtils := domain.NewProjectUtils()
 
 	// Create version checker
-	versionChecker := NewVersionChecker(version)
+	versionChecker := deps.VersionChecker
+	if versionChecker == nil {
+		versionChecker = NewVersionChecker(version)
+	}
 
 	// Create legacy table (to be removed)
 	rows := []btable.Row{}
@@ -174,7 +255,7 @@ func New(client api.ClientInterface, version string, tracer *tracing.TUIIntegrat
 		fileManager:         fileManager,
 		configManager:       configManager,
 		client:              client,
-		downloader:          gitDownloader,
+		downloader:          projectDownloader,
 		testRunner:          testRunner,
 		projectService:      projectService,
 		projectUtils:        projectUtils,
@@ -196,6 +277,8 @@ func (c *Controller) Init() tea.Cmd {
 	commands := []tea.Cmd{
 		c.checkVersionCmd(),
 		c.versionTickerCmd(),
+		c.checkIncompleteDownloadsCmd(),
+		c.daySummaryCmd(),
 	}
 
 	if c.configManager.HasCredentials() {
@@ -214,6 +297,18 @@ func (c *Controller) Update(msg tea.Msg) (*Controller, tea.Cmd) {
 		return c, tea.Quit
 	}
 
+	// Remember the terminal size for components created after the resize. The test component
+	// outlives state changes, so it gets the size even when resized on another screen.
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		c.width = size.Width
+		c.testComponent.SetSize(size.Width, size.Height)
+	}
+
+	// Drop project lists fetched for a menu that has since been left
+	if requestID, ok := projectsRequestID(msg); ok && !c.acceptProjects(requestID) {
+		return c, nil
+	}
+
 	// Handle global messages
 	switch msg := msg.(type) {
 	case VersionCheckMsg:
@@ -221,6 +316,62 @@ func (c *Controller) Update(msg tea.Msg) (*Controller, tea.Cmd) {
 		return c, nil
 	case VersionTickerMsg:
 		return c, c.checkVersionCmd()
+	case IncompleteDownloadsMsg:
+		c.incompleteDownloads = msg.Downloads
+		return c, nil
+	case SupportBundleMsg:
+		if msg.Error != nil {
+			if c.tracer != nil {
+				_ = c.tracer.TrackError(msg.Error, "controller", "support_bundle")
+			}
+			c.statusMsg = ""
+			c.errorMsg = "Failed to create support bundle: " + msg.Error.Error()
+			return c, nil
+		}
+		c.statusMsg = "Support bundle saved to " + msg.Path
+		return c, nil
+	case IncompleteDownloadsCleanedMsg:
+		c.incompleteDownloads = withoutDirs(c.incompleteDownloads, msg.Cleaned)
+		if msg.Error != nil {
+			c.errorMsg = "Failed to clean up interrupted downloads: " + msg.Error.Error()
+			return c, nil
+		}
+		c.statusMsg = "Removed interrupted downloads. Download them again to start fresh."
+		return c, nil
+	case AnnouncementMsg:
+		c.announcement = nil
+		if a := msg.Announcement; a != nil && a.ID != "" && !c.configManager.IsAnnouncementDismissed(a.ID) {
+			c.announcement = a
+		}
+		return c, nil
+	case DaySummaryMsg:
+		c.daySummary = msg.Summary
+		return c, nil
+	case AdoptCandidatesMsg:
+		c.statusMsg = ""
+		if msg.Error != nil {
+			c.errorMsg = "Failed to fetch projects: " + msg.Error.Error()
+			return c, nil
+		}
+		if len(msg.Candidates) == 0 {
+			c.statusMsg = "No unrecorded project is named like one of your 404skill projects (<project_name>_<project_id>)."
+			return c, nil
+		}
+		c.adoptQueue = msg.Candidates
+		return c, nil
+	case DownloadsAdoptedMsg:
+		c.incompleteDownloads = withoutDirs(c.incompleteDownloads, msg.Adopted)
+		if msg.Error != nil {
+			c.errorMsg = "Failed to mark project as downloaded: " + msg.Error.Error()
+			return c, nil
+		}
+		c.statusMsg = "Marked " + strings.Join(msg.Adopted, ", ") + " as downloaded."
+		return c, nil
+	case PendingInitsRetriedMsg:
+		if msg.Error != nil && c.tracer != nil {
+			_ = c.tracer.TrackError(msg.Error, "controller", "retry_pending_inits")
+		}
+		return c, nil
 	case state.ErrorMsg:
 		c.errorMsg = msg.Error.Error()
 		return c, nil
@@ -251,6 +402,8 @@ func (c *Controller) handleStateUpdate(msg tea.Msg) (*Controller, tea.Cmd) {
 		return c.handleTestProjectVariantMenuState(msg)
 	case state.TestProject:
 		return c.handleTestProjectState(msg)
+	case state.FailingTests:
+		return c.handleFailingTestsState(msg)
 	default:
 		return c, nil
 	}
@@ -264,7 +417,7 @@ func (c *Controller) handleRefreshingTokenState(msg tea.Msg) (*Controller, tea.C
 			if c.tracer != nil {
 				_ = c.tracer.TrackStateChange("refreshing_token", "main_menu", "token_refresh_success")
 			}
-			return c, c.stateMachine.Transition(state.MainMenu)
+			return c, tea.Batch(c.stateMachine.Transition(state.MainMenu), c.retryPendingInitsCmd(), c.announcementCmd())
 		} else {
 			if c.tracer != nil {
 				_ = c.tracer.TrackError(msg.Error, "controller", "token_refresh")
@@ -282,13 +435,53 @@ func (c *Controller) handleRefreshingTokenState(msg tea.Msg) (*Controller, tea.C
 }
 
 func (c *Controller) handleMainMenuState(msg tea.Msg) (*Controller, tea.Cmd) {
+	// Each unrecorded checkout is only marked as downloaded once the user confirms it
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && len(c.adoptQueue) > 0 {
+		candidate := c.adoptQueue[0]
+		switch keyMsg.String() {
+		case "y":
+			c.adoptQueue = c.adoptQueue[1:]
+			return c, c.adoptDownloadCmd(candidate)
+		case "n":
+			c.adoptQueue = c.adoptQueue[1:]
+		case "esc":
+			c.adoptQueue = nil
+		}
+		return c, nil
+	}
 	// Update main menu component
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "x" && len(interruptedDownloads(c.incompleteDownloads)) > 0 {
+		return c, c.cleanIncompleteDownloadsCmd(c.incompleteDownloads)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "d" && c.announcement != nil {
+		if err := c.configManager.DismissAnnouncement(c.announcement.ID); err != nil {
+			c.errorMsg = "Failed to dismiss announcement: " + err.Error()
+			return c, nil
+		}
+		c.announcement = nil
+		return c, nil
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "a" && len(interruptedDownloads(c.incompleteDownloads)) < len(c.incompleteDownloads) {
+		c.statusMsg = "Checking unrecorded projects..."
+		return c, c.adoptCandidatesCmd(c.incompleteDownloads)
+	}
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "B" {
+		c.statusMsg = "Creating support bundle..."
+		return c, c.createSupportBundleCmd()
+	}
+
 	var menuCmd tea.Cmd
 	c.mainMenu, menuCmd = c.mainMenu.Update(msg)
 
 	switch msg := msg.(type) {
 	case menu.MenuSelectMsg:
 		c.selectedAction = MainMenuAction(msg.SelectedIndex)
+		if c.selectedAction == FailingTests {
+			if c.tracer != nil {
+				_ = c.tracer.TrackStateChange("main_menu", "failing_tests", "failing_tests_selected")
+			}
+			return c, c.openFailingTests()
+		}
 		c.loading = true
 
 		// Track menu selection
@@ -306,7 +499,7 @@ func (c *Controller) handleMainMenuState(msg tea.Msg) (*Controller, tea.Cmd) {
 			}
 			return c, tea.Batch(
 				c.stateMachine.Transition(state.TestProjectNameMenu),
-				c.projectService.FetchProjects(),
+				c.fetchProjectsCmd(state.TestProjectNameMenu),
 			)
 		} else {
 			if c.tracer != nil {
@@ -314,7 +507,7 @@ func (c *Controller) handleMainMenuState(msg tea.Msg) (*Controller, tea.Cmd) {
 			}
 			return c, tea.Batch(
 				c.stateMachine.Transition(state.ProjectNameMenu),
-				c.projectService.FetchProjects(),
+				c.fetchProjectsCmd(state.ProjectNameMenu),
 			)
 		}
 	case login.LoginSuccessMsg:
@@ -353,21 +546,24 @@ func (c *Controller) handleLoginState(msg tea.Msg) (*Controller, tea.Cmd) {
 		if c.tracer != nil {
 			_ = c.tracer.TrackStateChange("login", "main_menu", "login_success")
 		}
-		return c, c.stateMachine.Transition(state.MainMenu)
+		// Let the component reset its failure count; its own success command would only loop back here
+		c.loginComponent, _ = c.loginComponent.Update(msg)
+		return c, tea.Batch(c.stateMachine.Transition(state.MainMenu), c.retryPendingInitsCmd(), c.announcementCmd())
 	case login.LoginErrorMsg:
 		if c.tracer != nil {
 			_ = c.tracer.TrackError(fmt.Errorf("%s", msg.Error), "controller", "login")
 		}
-		c.loginComponent.SetError(msg.Error)
-		return c, nil
 	}
-	return c, nil
+	// The component shows errors and runs the cooldown after repeated failures
+	updatedComponent, cmd := c.loginComponent.Update(msg)
+	c.loginComponent = updatedComponent
+	return c, cmd
 }
 
 func (c *Controller) handleProjectNameMenuState(msg tea.Msg) (*Controller, tea.Cmd) {
 	// Update project name menu if projects are loaded
 	if len(c.projects) > 0 && len(c.projectNameMenu.GetItems()) == 0 {
-		c.projectNameMenu.SetItems(c.projectUtils.ExtractUniqueNames(c.projects))
+		c.projectNameMenu.SetItems(c.projectNameMenuItems())
 	}
 
 	var cmd tea.Cmd
@@ -375,7 +571,27 @@ func (c *Controller) handleProjectNameMenuState(msg tea.Msg) (*Controller, tea.C
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if c.keyHandler.IsEnter(msg) {
+		if msg.String() == "c" && c.errorMsg != "" {
+			c.copyErrorReport()
+			return c, nil
+		}
+		if msg.String() == "d" && !c.loading {
+			c.downloadedOnly = !c.downloadedOnly
+			c.projectNameMenu.SetItems(c.projectNameMenuItems())
+			if c.tracer != nil {
+				_ = c.tracer.TrackMenuNavigation("project_name_menu", "downloaded_only", strconv.FormatBool(c.downloadedOnly))
+			}
+			return c, nil
+		}
+		if msg.String() == "s" && !c.loading {
+			c.nameMenuSort = c.nameMenuSort.Next()
+			c.projectNameMenu.SetItems(c.projectNameMenuItems())
+			if c.tracer != nil {
+				_ = c.tracer.TrackMenuNavigation("project_name_menu", "sort", c.nameMenuSort.String())
+			}
+			return c, nil
+		}
+		if c.keyHandler.IsEnter(msg) && !c.projectNameMenu.IsEmpty() {
 			selectedName := c.projectNameMenu.GetSelectedItem()
 			c.selectedProjectName = selectedName
 
@@ -386,12 +602,14 @@ func (c *Controller) handleProjectNameMenuState(msg tea.Msg) (*Controller, tea.C
 
 			variants := c.projectUtils.FilterByName(c.projects, c.selectedProjectName)
 			c.variantComponent = variant.New(variants, c.downloader, c.configManager, c.fileManager)
+			c.variantComponent.SetWidth(c.width)
 			return c, c.stateMachine.Transition(state.ProjectVariantMenu)
 		}
 		if c.keyHandler.IsBack(msg) {
 			if c.tracer != nil {
 				_ = c.tracer.TrackStateChange("project_name_menu", "main_menu", "back_key")
 			}
+			c.stopFetch()
 			return c, c.stateMachine.Transition(state.MainMenu)
 		}
 	case domain.ProjectsLoadedMsg:
@@ -400,14 +618,16 @@ func (c *Controller) handleProjectNameMenuState(msg tea.Msg) (*Controller, tea.C
 			_ = projectTracker.Complete()
 		}
 		c.projects = msg.Projects
-		c.projectNameMenu.SetItems(c.projectUtils.ExtractUniqueNames(c.projects))
+		c.projectNameMenu.SetItems(c.projectNameMenuItems())
 		c.loading = false
+		c.errorMsg = ""
 		return c, nil
 	case domain.ProjectsErrorMsg:
 		if c.tracer != nil {
 			_ = c.tracer.TrackError(msg.Error, "controller", "fetch_projects")
 		}
 		c.errorMsg = msg.Error.Error()
+		c.statusMsg = ""
 		c.loading = false
 		return c, nil
 	}
@@ -415,11 +635,53 @@ func (c *Controller) handleProjectNameMenuState(msg tea.Msg) (*Controller, tea.C
 	return c, cmd
 }
 
+// projectNameMenuItems lists the download menu's project names in the chosen order, only the
+// downloaded ones while that filter is on
+func (c *Controller) projectNameMenuItems() []string {
+	projects := c.projects
+	if c.downloadedOnly {
+		projects = c.downloadedProjects()
+	}
+	sorted := domain.SortByCompletion(projects, c.nameProgress(projects), c.nameMenuSort)
+	return c.projectUtils.ExtractUniqueNames(sorted)
+}
+
+// nameProgress counts a project's progress as that of the furthest along variant with its name, since
+// the menu lists each name once
+func (c *Controller) nameProgress(projects []api.Project) func(projectID string) int {
+	names := make(map[string]string)
+	completed := make(map[string]int)
+	for _, p := range projects {
+		names[p.ID] = p.Name
+		completed[p.Name] = max(completed[p.Name], len(c.configManager.GetCompletedTasks(p.ID)))
+	}
+	return func(projectID string) int {
+		return completed[names[projectID]]
+	}
+}
+
+// downloadedProjects returns the loaded projects that have been downloaded
+func (c *Controller) downloadedProjects() []api.Project {
+	downloaded := []api.Project{}
+	for _, project := range c.projects {
+		if c.configManager.IsProjectDownloaded(project.ID) {
+			downloaded = append(downloaded, project)
+		}
+	}
+	return downloaded
+}
+
 func (c *Controller) handleProjectVariantMenuState(msg tea.Msg) (*Controller, tea.Cmd) {
 	if c.variantComponent != nil {
 		updated, cmd := c.variantComponent.Update(msg)
 		c.variantComponent = updated
 
+		// A clean download needs no further setup, so its tests can start straight away
+		if done, ok := msg.(variant.DownloadCompleteMsg); ok && done.Warning == "" && done.Variant != nil &&
+			c.configManager.IsAutoTestAfterDownloadEnabled() {
+			return c, c.autoTestAfterDownload(done.Variant)
+		}
+
 		if _, ok := msg.(variant.BackMsg); ok {
 			if c.tracer != nil {
 				_ = c.tracer.TrackStateChange("project_variant_menu", "project_name_menu", "back_action")
@@ -432,17 +694,25 @@ func (c *Controller) handleProjectVariantMenuState(msg tea.Msg) (*Controller, te
 	return c, nil
 }
 
+// autoTestAfterDownload opens the test view of a freshly downloaded variant and starts its tests
+func (c *Controller) autoTestAfterDownload(downloaded *api.Project) tea.Cmd {
+	if c.tracer != nil {
+		_ = c.tracer.TrackStateChange("project_variant_menu", "test_project_variant_menu", "auto_test_after_download")
+	}
+	c.selectedProjectName = downloaded.Name
+	c.testVariantComponent = variant.NewForTesting([]api.Project{*downloaded}, c.testRunner, c.configManager, c.fileManager)
+	c.testVariantComponent.SetWidth(c.width)
+	return tea.Batch(
+		c.stateMachine.Transition(state.TestProjectVariantMenu),
+		c.testVariantComponent.StartAutoTest(downloaded),
+	)
+}
+
 func (c *Controller) handleTestProjectNameMenuState(msg tea.Msg) (*Controller, tea.Cmd) {
 	// Update test project name menu if projects are loaded
 	if len(c.projects) > 0 && len(c.testProjectNameMenu.GetItems()) == 0 {
 		// Filter to only show downloaded projects for testing
-		downloadedProjects := []api.Project{}
-		for _, project := range c.projects {
-			if c.configManager.IsProjectDownloaded(project.ID) {
-				downloadedProjects = append(downloadedProjects, project)
-			}
-		}
-		c.testProjectNameMenu.SetItems(c.projectUtils.ExtractUniqueNames(downloadedProjects))
+		c.testProjectNameMenu.SetItems(c.projectUtils.ExtractUniqueNames(c.downloadedProjects()))
 	}
 
 	var cmd tea.Cmd
@@ -450,6 +720,10 @@ func (c *Controller) handleTestProjectNameMenuState(msg tea.Msg) (*Controller, t
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if msg.String() == "c" && c.errorMsg != "" {
+			c.copyErrorReport()
+			return c, nil
+		}
 		if c.keyHandler.IsEnter(msg) {
 			selectedName := c.testProjectNameMenu.GetSelectedItem()
 			c.selectedProjectName = selectedName
@@ -460,21 +734,16 @@ func (c *Controller) handleTestProjectNameMenuState(msg tea.Msg) (*Controller, t
 			}
 
 			// Filter to only downloaded projects
-			downloadedProjects := []api.Project{}
-			for _, project := range c.projects {
-				if c.configManager.IsProjectDownloaded(project.ID) {
-					downloadedProjects = append(downloadedProjects, project)
-				}
-			}
-
-			variants := c.projectUtils.FilterByName(downloadedProjects, c.selectedProjectName)
+			variants := c.projectUtils.FilterByName(c.downloadedProjects(), c.selectedProjectName)
 			c.testVariantComponent = variant.NewForTesting(variants, c.testRunner, c.configManager, c.fileManager)
+			c.testVariantComponent.SetWidth(c.width)
 			return c, c.stateMachine.Transition(state.TestProjectVariantMenu)
 		}
 		if c.keyHandler.IsBack(msg) {
 			if c.tracer != nil {
 				_ = c.tracer.TrackStateChange("test_project_name_menu", "main_menu", "back_key")
 			}
+			c.stopFetch()
 			return c, c.stateMachine.Transition(state.MainMenu)
 		}
 	case domain.ProjectsLoadedMsg:
@@ -483,21 +752,19 @@ func (c *Controller) handleTestProjectNameMenuState(msg tea.Msg) (*Controller, t
 			_ = projectTracker.Complete()
 		}
 		c.projects = msg.Projects
+		// The results look up the project's web page among these
+		c.testComponent.SetProjects(c.projects)
 		// Filter to only show downloaded projects for testing
-		downloadedProjects := []api.Project{}
-		for _, project := range c.projects {
-			if c.configManager.IsProjectDownloaded(project.ID) {
-				downloadedProjects = append(downloadedProjects, project)
-			}
-		}
-		c.testProjectNameMenu.SetItems(c.projectUtils.ExtractUniqueNames(downloadedProjects))
+		c.testProjectNameMenu.SetItems(c.projectUtils.ExtractUniqueNames(c.downloadedProjects()))
 		c.loading = false
+		c.errorMsg = ""
 		return c, nil
 	case domain.ProjectsErrorMsg:
 		if c.tracer != nil {
 			_ = c.tracer.TrackError(msg.Error, "controller", "fetch_projects_for_testing")
 		}
 		c.errorMsg = msg.Error.Error()
+		c.statusMsg = ""
 		c.loading = false
 		return c, nil
 	}
@@ -526,16 +793,33 @@ func (c *Controller) handleTestProjectVariantMenuState(msg tea.Msg) (*Controller
 					if !ok {
 						return test.TestErrorMsg{Error: "Invalid test result format"}
 					}
-					return test.TestCompleteMsg{
-						Project: &testrunner.Project{
-							ID:       msg.Variant.ID,
-							Name:     msg.Variant.Name,
-							Language: msg.Variant.Language,
-						},
-						Result: testResult,
+					project := testrunner.Project{
+						ID:       msg.Variant.ID,
+						Name:     msg.Variant.Name,
+						Language: msg.Variant.Language,
+					}
+					completed := test.TestCompleteMsg{
+						Project:    &project,
+						Result:     testResult,
+						SourceHash: msg.SourceHash,
+					}
+					if reporter, ok := c.testRunner.(testrunner.UsageReporter); ok {
+						if usage, ok := reporter.LastUsage(project); ok {
+							completed.Usage = &usage
+						}
 					}
+					return completed
 				},
 			)
+		case variant.ShowCachedRunMsg:
+			if c.tracer != nil {
+				_ = c.tracer.TrackStateChange("test_project_variant_menu", "test_project", "cached_run_shown")
+			}
+			project := testrunner.Project{ID: msg.Variant.ID, Name: msg.Variant.Name, Language: msg.Variant.Language}
+			return c, tea.Batch(
+				c.stateMachine.Transition(state.TestProject),
+				func() tea.Msg { return test.ShowCachedRunMsg{Project: project, Run: msg.Run} },
+			)
 		case variant.TestErrorMsg:
 			if c.tracer != nil {
 				_ = c.tracer.TrackError(fmt.Errorf("%s", msg.Error), "controller", "test_execution")
@@ -559,7 +843,15 @@ func (c *Controller) handleTestProjectVariantMenuState(msg tea.Msg) (*Controller
 func (c *Controller) handleTestProjectState(msg tea.Msg) (*Controller, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		if c.keyHandler.IsBack(msg) {
+		// The log viewer handles its own back navigation
+		if c.keyHandler.IsBack(msg) && !c.testComponent.IsViewingLog() {
+			// Results opened from the failing tests overview go back there
+			if c.failingComponent != nil {
+				if c.tracer != nil {
+					_ = c.tracer.TrackStateChange("test_project", "failing_tests", "back_key")
+				}
+				return c, c.stateMachine.Transition(state.FailingTests)
+			}
 			if c.tracer != nil {
 				_ = c.tracer.TrackStateChange("test_project", "main_menu", "back_key")
 			}
@@ -567,6 +859,7 @@ func (c *Controller) handleTestProjectState(msg tea.Msg) (*Controller, tea.Cmd)
 		}
 	case domain.ProjectsLoadedMsg:
 		c.projects = msg.Projects
+		c.testComponent.SetProjects(c.projects)
 		c.loading = false
 		return c, nil
 	case domain.ProjectsErrorMsg:
@@ -574,6 +867,7 @@ func (c *Controller) handleTestProjectState(msg tea.Msg) (*Controller, tea.Cmd)
 			_ = c.tracer.TrackError(msg.Error, "controller", "test_project_state")
 		}
 		c.errorMsg = msg.Error.Error()
+		c.statusMsg = ""
 		c.loading = false
 		return c, nil
 	}
@@ -581,6 +875,51 @@ func (c *Controller) handleTestProjectState(msg tea.Msg) (*Controller, tea.Cmd)
 	// Delegate to test component
 	updatedComponent, cmd := c.testComponent.Update(msg)
 	c.testComponent = updatedComponent
+	if _, ok := msg.(test.TestCompleteMsg); ok {
+		// The component has recorded the run; refresh today's totals
+		cmd = tea.Batch(cmd, c.daySummaryCmd())
+	}
+	return c, cmd
+}
+
+// openFailingTests aggregates the latest saved results of every downloaded project
+func (c *Controller) openFailingTests() tea.Cmd {
+	runs, err := testreport.LoadLastRuns(c.configManager.GetResultsDir())
+	if err != nil {
+		c.errorMsg = "Failed to load saved test results: " + err.Error()
+		return nil
+	}
+	c.errorMsg = ""
+	failures := failing.Aggregate(runs, c.configManager.GetDownloadedProjects(), c.configManager.GetExcludedTestPatterns())
+	c.failingComponent = failing.New(failures)
+	return c.stateMachine.Transition(state.FailingTests)
+}
+
+func (c *Controller) handleFailingTestsState(msg tea.Msg) (*Controller, tea.Cmd) {
+	switch msg := msg.(type) {
+	case failing.OpenResultsMsg:
+		if c.tracer != nil {
+			_ = c.tracer.TrackStateChange("failing_tests", "test_project", "open_results")
+		}
+		project := &testrunner.Project{ID: msg.Run.ProjectID, Name: msg.Run.ProjectName, Language: msg.Run.Language}
+		result := msg.Run.Result
+		return c, tea.Batch(
+			c.stateMachine.Transition(state.TestProject),
+			func() tea.Msg { return test.ShowStoredResultsMsg{Project: project, Result: result} },
+		)
+	case failing.CloseMsg:
+		if c.tracer != nil {
+			_ = c.tracer.TrackStateChange("failing_tests", "main_menu", "back_key")
+		}
+		c.failingComponent = nil
+		return c, c.stateMachine.Transition(state.MainMenu)
+	}
+
+	if c.failingComponent == nil {
+		return c, nil
+	}
+	var cmd tea.Cmd
+	c.failingComponent, cmd = c.failingComponent.Update(msg)
 	return c, cmd
 }
 
@@ -593,10 +932,17 @@ func (c *Controller) View() string {
 	switch c.stateMachine.Current() {
 	case state.RefreshingToken:
 		return c.renderRefreshingToken()
-	case state.MainMenu:
-		return c.renderMainMenu()
 	case state.Login:
 		return c.renderLogin()
+	}
+	return c.renderState() + c.renderDaySummary()
+}
+
+// renderState renders the screen of a logged-in state
+func (c *Controller) renderState() string {
+	switch c.stateMachine.Current() {
+	case state.MainMenu:
+		return c.renderMainMenu()
 	case state.ProjectNameMenu:
 		return c.renderProjectNameMenu()
 	case state.ProjectVariantMenu:
@@ -607,6 +953,8 @@ func (c *Controller) View() string {
 		return c.renderTestProjectVariantMenu()
 	case state.TestProject:
 		return c.renderTestProject()
+	case state.FailingTests:
+		return c.renderFailingTests()
 	default:
 		return "Unknown state"
 	}
@@ -637,8 +985,23 @@ func (c *Controller) GetVersionInfo() VersionInfo {
 	return c.versionInfo
 }
 
+// copyErrorReport copies the current error with version and session details for a support ticket
+func (c *Controller) copyErrorReport() {
+	report := support.FormatErrorReport(c.errorMsg, c.versionInfo.CurrentVersion, support.SessionID(), time.Now())
+	if err := c.fileManager.CopyToClipboard(report); err != nil {
+		if c.tracer != nil {
+			_ = c.tracer.TrackError(err, "controller", "copy_error_report")
+		}
+		c.statusMsg = fmt.Sprintf("Could not copy to clipboard: %v", err)
+		return
+	}
+	c.statusMsg = "Error details copied to clipboard"
+}
+
 // cleanup properly shuts down background processes and tickers
 func (c *Controller) cleanup() {
+	c.stopFetch()
+
 	// Track application shutdown
 	if c.tracer != nil {
 		_ = c.tracer.TrackStateChange(c.stateMachine.Current().String(), "application_exit", "user_quit")
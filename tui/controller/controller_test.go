@@ -0,0 +1,599 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+	"404skill-cli/downloader"
+	"404skill-cli/testreport"
+	"404skill-cli/testrunner"
+	"404skill-cli/tui/components/menu"
+	"404skill-cli/tui/domain"
+	"404skill-cli/tui/login"
+	"404skill-cli/tui/state"
+	"404skill-cli/tui/test"
+	"404skill-cli/tui/variant"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// stubClient returns a fixed project list
+type stubClient struct {
+	projects []api.Project
+}
+
+func (s *stubClient) ListProjects(ctx context.Context) ([]api.Project, error) {
+	return s.projects, nil
+}
+
+func (s *stubClient) InitializeProject(ctx context.Context, projectID string) error {
+	return nil
+}
+
+func (s *stubClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*api.BulkUpdateResponse, error) {
+	return &api.BulkUpdateResponse{}, nil
+}
+
+// stubVersionChecker never reports an update
+type stubVersionChecker struct{}
+
+func (stubVersionChecker) CheckForUpdates(ctx context.Context) VersionInfo {
+	return VersionInfo{CurrentVersion: "test"}
+}
+
+// newTestController builds a controller at the main menu with a temp config
+func newTestController(t *testing.T) *Controller {
+	t.Helper()
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	if err := os.WriteFile(config.ConfigFilePath, []byte("{}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	deps := Dependencies{
+		ConfigManager:  config.NewConfigManager(nil),
+		VersionChecker: stubVersionChecker{},
+		SkipLogin:      true,
+	}
+	c, err := NewWithDependencies(&stubClient{}, "test", nil, deps)
+	if err != nil {
+		t.Fatalf("Failed to create controller: %v", err)
+	}
+	return c
+}
+
+var lateProjects = []api.Project{{ID: "p1", Name: "Key Value Store", Language: "go"}}
+
+func TestController_ProjectsArrivingAfterLeavingMenuAreIgnored(t *testing.T) {
+	// Arrange - open the download menu, then go back before the projects arrive
+	c := newTestController(t)
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(DownloadProject)})
+	requestID := c.fetchID
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Act
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: lateProjects, RequestID: requestID})
+
+	// Assert
+	if c.CurrentState() != state.MainMenu {
+		t.Errorf("Expected to stay on the main menu, got %s", c.CurrentState())
+	}
+	if len(c.projects) != 0 || len(c.projectNameMenu.GetItems()) != 0 {
+		t.Errorf("Expected the late projects to be ignored, got %v", c.projects)
+	}
+}
+
+func TestController_StaleFetchIsIgnoredByTheNextMenu(t *testing.T) {
+	// Arrange - leave the download menu and open the test menu, which starts a new fetch
+	c := newTestController(t)
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(DownloadProject)})
+	staleID := c.fetchID
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(TestProject)})
+
+	// Act
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: lateProjects, RequestID: staleID})
+
+	// Assert
+	if len(c.projects) != 0 {
+		t.Errorf("Expected the stale response to be ignored, got %v", c.projects)
+	}
+	if !c.loading {
+		t.Error("Expected the test menu to still wait for its own fetch")
+	}
+
+	// The current fetch is still accepted
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: lateProjects, RequestID: c.fetchID})
+	if len(c.projects) != 1 {
+		t.Errorf("Expected the current fetch to populate the menu, got %v", c.projects)
+	}
+}
+
+// announcingClient also serves a platform announcement
+type announcingClient struct {
+	stubClient
+	announcement *api.Announcement
+	err          error
+	calls        int
+}
+
+func (a *announcingClient) GetAnnouncement(ctx context.Context) (*api.Announcement, error) {
+	a.calls++
+	return a.announcement, a.err
+}
+
+func TestController_AnnouncementDismissedByID(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	client := &announcingClient{announcement: &api.Announcement{ID: "maint-42", Message: "Maintenance on Sunday"}}
+	c.client = client
+	c, _ = c.Update(c.announcementCmd()())
+	if !strings.Contains(c.View(), "Maintenance on Sunday") {
+		t.Fatalf("Expected the announcement banner, got:\n%s", c.View())
+	}
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+
+	// Assert
+	if strings.Contains(c.View(), "Maintenance on Sunday") {
+		t.Error("Expected the banner to be dismissed")
+	}
+	if !c.configManager.IsAnnouncementDismissed("maint-42") {
+		t.Error("Expected the dismissal to be saved")
+	}
+	c, _ = c.Update(c.announcementCmd()())
+	if c.announcement != nil {
+		t.Error("Expected a dismissed announcement to stay hidden")
+	}
+	if client.calls != 1 {
+		t.Errorf("Expected the cached announcement to be reused, got %d fetches", client.calls)
+	}
+}
+
+func TestController_AnnouncementEndpointUnavailable(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	c.client = &announcingClient{err: errors.New("unexpected status code: 404")}
+
+	// Act
+	c, _ = c.Update(c.announcementCmd()())
+
+	// Assert
+	if c.announcement != nil || c.errorMsg != "" {
+		t.Errorf("Expected the announcement to be skipped quietly, got %+v / %q", c.announcement, c.errorMsg)
+	}
+}
+
+// openVariantMenu puts the controller on the download variant menu of a project
+func openVariantMenu(c *Controller, project api.Project) {
+	c.stateMachine.Transition(state.ProjectVariantMenu)
+	c.variantComponent = variant.New([]api.Project{project}, nil, c.configManager, nil)
+}
+
+func TestController_DownloadComplete_AutoRunsTestsWhenEnabled(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	settings := "auto_test_after_download: true\ndownloaded_projects:\n  p1: true\n"
+	if err := os.WriteFile(config.ConfigFilePath, []byte(settings), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	project := lateProjects[0]
+	openVariantMenu(c, project)
+
+	// Act
+	c, cmd := c.Update(variant.DownloadCompleteMsg{Variant: &project})
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected the test run to be started")
+	}
+	if c.CurrentState() != state.TestProjectVariantMenu {
+		t.Errorf("Expected to switch to the test view, got %s", c.CurrentState())
+	}
+	if c.testVariantComponent == nil || !c.testVariantComponent.IsTesting() {
+		t.Fatal("Expected the downloaded variant to be testing")
+	}
+	if !strings.Contains(c.testVariantComponent.View(), "auto-run after download") {
+		t.Errorf("Expected the auto-run to be indicated, got:\n%s", c.testVariantComponent.View())
+	}
+}
+
+func TestController_DownloadComplete_NoAutoRunWhenDisabled(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	project := lateProjects[0]
+	openVariantMenu(c, project)
+
+	// Act
+	c, _ = c.Update(variant.DownloadCompleteMsg{Variant: &project})
+
+	// Assert
+	if c.CurrentState() != state.ProjectVariantMenu {
+		t.Errorf("Expected to stay on the variant menu, got %s", c.CurrentState())
+	}
+	if c.testVariantComponent != nil {
+		t.Error("Expected no test run to be started")
+	}
+}
+
+func TestController_DownloadComplete_NoAutoRunAfterWarning(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	if err := os.WriteFile(config.ConfigFilePath, []byte("auto_test_after_download: true\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	project := lateProjects[0]
+	openVariantMenu(c, project)
+
+	// Act
+	c, _ = c.Update(variant.DownloadCompleteMsg{Variant: &project, Warning: "post-download hook failed"})
+
+	// Assert
+	if c.testVariantComponent != nil {
+		t.Error("Expected no test run when the download needs further setup")
+	}
+}
+
+func TestController_DownloadedOnlyFilter(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	if err := os.WriteFile(config.ConfigFilePath, []byte("downloaded_projects:\n  p1: true\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	projects := []api.Project{
+		{ID: "p1", Name: "Key Value Store", Language: "go"},
+		{ID: "p2", Name: "Rate Limiter", Language: "go"},
+	}
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(DownloadProject)})
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: projects, RequestID: c.fetchID})
+	if items := c.projectNameMenu.GetItems(); len(items) != 2 {
+		t.Fatalf("Expected every project before filtering, got %v", items)
+	}
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+
+	// Assert
+	if items := c.projectNameMenu.GetItems(); len(items) != 1 || items[0] != "Key Value Store" {
+		t.Errorf("Expected only the downloaded project, got %v", items)
+	}
+	if !strings.Contains(c.View(), "downloaded only") {
+		t.Errorf("Expected the active filter in the header, got:\n%s", c.View())
+	}
+
+	// Toggling again shows every project
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if items := c.projectNameMenu.GetItems(); len(items) != 2 {
+		t.Errorf("Expected every project after turning the filter off, got %v", items)
+	}
+}
+
+func TestController_DownloadMenuSortByCompletion(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	cfg := "completed_tasks:\n  p2: [1, 2, 3]\n  p3: [1]\n"
+	if err := os.WriteFile(config.ConfigFilePath, []byte(cfg), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	projects := []api.Project{
+		{ID: "p1", Name: "Key Value Store", Language: "go"},
+		{ID: "p2", Name: "Rate Limiter", Language: "go"},
+		{ID: "p3", Name: "URL Shortener", Language: "go"},
+		{ID: "p4", Name: "Key Value Store", Language: "python"},
+	}
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(DownloadProject)})
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: projects, RequestID: c.fetchID})
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+
+	// Assert
+	want := []string{"Key Value Store", "URL Shortener", "Rate Limiter"}
+	if items := c.projectNameMenu.GetItems(); strings.Join(items, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected least complete first %v, got %v", want, items)
+	}
+	if !strings.Contains(c.View(), "sort: least complete first") {
+		t.Errorf("Expected the sort mode in the footer, got:\n%s", c.View())
+	}
+
+	// The next mode puts the furthest along first
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	want = []string{"Rate Limiter", "URL Shortener", "Key Value Store"}
+	if items := c.projectNameMenu.GetItems(); strings.Join(items, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected most complete first %v, got %v", want, items)
+	}
+}
+
+func TestController_TestVariantMenuOpensRunHistory(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	settings := "downloaded_projects:\n  p1: true\nrun_history:\n  p1:\n    - time: 2026-01-02T15:04:00Z\n      passed: 7\n      failed: 2\n      duration: 12.5\n"
+	if err := os.WriteFile(config.ConfigFilePath, []byte(settings), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(TestProject)})
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: lateProjects, RequestID: c.fetchID})
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if c.CurrentState() != state.TestProjectVariantMenu {
+		t.Fatalf("Expected the test variant menu, got %s", c.CurrentState())
+	}
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+
+	// Assert
+	view := c.View()
+	if !strings.Contains(view, "Run History: Key Value Store") || !strings.Contains(view, "7 passed") {
+		t.Errorf("Expected the project's run history, got:\n%s", view)
+	}
+
+	// Closing the history returns to the variant menu
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd != nil {
+		c, _ = c.Update(cmd())
+	}
+	if c.CurrentState() != state.TestProjectVariantMenu || c.testVariantComponent.IsViewingHistory() {
+		t.Errorf("Expected to be back on the variant menu, got %s", c.CurrentState())
+	}
+}
+
+func TestController_CleanIncompleteDownloads_KeepsUnrecordedCheckouts(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	projectsDir := t.TempDir()
+	if err := os.WriteFile(config.ConfigFilePath, []byte("projects_dir: "+projectsDir+"\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	for _, dir := range []string{"journal_api_1/.git", "my_clone_2/.git"} {
+		if err := os.MkdirAll(filepath.Join(projectsDir, dir), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(projectsDir, ".journal_api_1"+downloader.InProgressMarkerSuffix), nil, 0644); err != nil {
+		t.Fatalf("Failed to write marker: %v", err)
+	}
+	c, _ = c.Update(c.checkIncompleteDownloadsCmd()())
+	view := c.View()
+	if !strings.Contains(view, "Interrupted download found: journal_api_1") || !strings.Contains(view, "Unrecorded project found: my_clone_2") {
+		t.Errorf("Expected the unrecorded checkout apart from the interrupted download, got:\n%s", view)
+	}
+
+	// Act
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if cmd == nil {
+		t.Fatal("Expected the interrupted download to be cleaned up")
+	}
+	c, _ = c.Update(cmd())
+
+	// Assert
+	if _, err := os.Stat(filepath.Join(projectsDir, "journal_api_1")); !os.IsNotExist(err) {
+		t.Errorf("Expected the interrupted download to be removed, stat returned: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(projectsDir, "my_clone_2", ".git")); err != nil {
+		t.Errorf("Expected the unrecorded checkout to be kept, stat returned: %v", err)
+	}
+	if len(c.incompleteDownloads) != 1 || c.incompleteDownloads[0].DirName != "my_clone_2" {
+		t.Errorf("Expected only the unrecorded checkout to remain listed, got %v", c.incompleteDownloads)
+	}
+
+	// With only unrecorded checkouts left, [x] does nothing
+	if _, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}); cmd != nil {
+		t.Error("Expected [x] to be ignored without interrupted downloads")
+	}
+}
+
+func TestController_AdoptConfirmsEachKnownProject(t *testing.T) {
+	// Arrange - one checkout is named like a known project, the others are the user's own
+	c := newTestController(t)
+	c.client.(*stubClient).projects = []api.Project{{ID: "1", Name: "Journal API"}, {ID: "2", Name: "Todo App"}}
+	projectsDir := t.TempDir()
+	if err := os.WriteFile(config.ConfigFilePath, []byte("projects_dir: "+projectsDir+"\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	for _, dir := range []string{"journal_api_1/.git", "todo_app_2/.git", "dotfiles_backup/.git", ".tests/journal_api_1"} {
+		if err := os.MkdirAll(filepath.Join(projectsDir, dir), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(projectsDir, ".tests", "journal_api_1", "docker-compose.test.yml"), []byte("services: {}\n"), 0644); err != nil {
+		t.Fatalf("Failed to write the test harness: %v", err)
+	}
+	c, _ = c.Update(c.checkIncompleteDownloadsCmd()())
+
+	// Act
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if cmd == nil {
+		t.Fatal("Expected [a] to look up the unrecorded projects")
+	}
+	c, _ = c.Update(cmd())
+
+	// Assert - each known checkout is asked about in turn
+	if len(c.adoptQueue) != 2 {
+		t.Fatalf("Expected only the two known checkouts to be offered, got %+v", c.adoptQueue)
+	}
+	if view := c.View(); !strings.Contains(view, "Mark journal_api_1 as your download of Journal API?") {
+		t.Errorf("Expected a confirmation for journal_api_1, got:\n%s", view)
+	}
+
+	c, cmd = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("Expected [y] to adopt the checkout")
+	}
+	c, _ = c.Update(cmd())
+	if view := c.View(); !strings.Contains(view, "Mark todo_app_2 as your download of Todo App?") {
+		t.Errorf("Expected a confirmation for todo_app_2, got:\n%s", view)
+	}
+	c, cmd = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if cmd != nil {
+		t.Error("Expected [n] to skip the checkout")
+	}
+
+	downloaded := c.configManager.GetDownloadedProjects()
+	if !downloaded["1"] || len(downloaded) != 1 {
+		t.Errorf("Expected only the confirmed project to be recorded, got %v", downloaded)
+	}
+	if len(c.adoptQueue) != 0 {
+		t.Errorf("Expected no confirmations left, got %+v", c.adoptQueue)
+	}
+}
+
+func TestController_ResultsUseTerminalHeightFromEarlierResize(t *testing.T) {
+	// Arrange - the terminal is sized once, on the main menu, before any results are open
+	c := newTestController(t)
+	c, _ = c.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}}
+	for i := 1; i <= 40; i++ {
+		name := fmt.Sprintf("test_case_%02d", i)
+		result.Suite.Results = append(result.Suite.Results, testreport.TestResult{Name: name, ClassName: "Task1Test", Passed: true})
+		result.PassedTests = append(result.PassedTests, name)
+	}
+	c.stateMachine.Transition(state.TestProject)
+
+	// Act
+	c, _ = c.Update(test.TestCompleteMsg{Project: &testrunner.Project{ID: "p1", Name: "Key Value Store"}, Result: result})
+
+	// Assert
+	if shown := strings.Count(c.View(), "test_case_"); shown <= 10 {
+		t.Errorf("Expected the results list to fill the 60-row terminal, got %d rows", shown)
+	}
+}
+
+func TestController_PrintViewPagesToTerminalHeight(t *testing.T) {
+	// Arrange - sized on the main menu, as when the app starts
+	c := newTestController(t)
+	c, _ = c.Update(tea.WindowSizeMsg{Width: 120, Height: 20})
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}}
+	for i := 1; i <= 40; i++ {
+		name := fmt.Sprintf("test_case_%02d", i)
+		result.Suite.Results = append(result.Suite.Results, testreport.TestResult{Name: name, ClassName: "Task1Test", Passed: true})
+		result.PassedTests = append(result.PassedTests, name)
+	}
+	c.stateMachine.Transition(state.TestProject)
+	c, _ = c.Update(test.TestCompleteMsg{Project: &testrunner.Project{ID: "p1", Name: "Key Value Store"}, Result: result})
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	firstPage := c.View()
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+
+	// Assert
+	if shown := strings.Count(firstPage, "[PASS]"); shown == 0 || shown > 18 {
+		t.Errorf("Expected one page of at most 18 report lines, got %d:\n%s", shown, firstPage)
+	}
+	if c.View() == firstPage {
+		t.Error("Expected scrolling to move the print view")
+	}
+}
+
+// runCmd feeds the messages of cmd, and of any batch it returns, back into the controller
+func runCmd(c *Controller, cmd tea.Cmd) *Controller {
+	if cmd == nil {
+		return c
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, sub := range batch {
+			c = runCmd(c, sub)
+		}
+		return c
+	}
+	c, next := c.Update(msg)
+	return runCmd(c, next)
+}
+
+// retryingDownloader counts retries of queued project initializations
+type retryingDownloader struct {
+	retries int
+}
+
+func (d *retryingDownloader) DownloadProject(ctx context.Context, project *api.Project, language string, progressCallback downloader.ProgressCallback) error {
+	return nil
+}
+
+func (d *retryingDownloader) RetryPendingInitializations(ctx context.Context) (int, error) {
+	d.retries++
+	return 1, nil
+}
+
+func TestController_LoginRetriesPendingInitializations(t *testing.T) {
+	// Arrange - the session expired, so the user logs in again
+	c := newTestController(t)
+	retrier := &retryingDownloader{}
+	c.downloader = retrier
+	c.stateMachine.Transition(state.Login)
+
+	// Act
+	c = runCmd(c, func() tea.Msg { return login.LoginSuccessMsg{} })
+
+	// Assert
+	if retrier.retries != 1 {
+		t.Errorf("Expected the pending initializations to be retried once after login, got %d", retrier.retries)
+	}
+	if got := c.stateMachine.Current(); got != state.MainMenu {
+		t.Errorf("Expected the main menu after login, got %s", got)
+	}
+}
+
+func TestController_ResultsOfTestRunLinkProjectPage(t *testing.T) {
+	// Arrange - the project list comes from the API with a page URL, as in the live app
+	c := newTestController(t)
+	if err := os.WriteFile(config.ConfigFilePath, []byte("downloaded_projects:\n  p1: true\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	project := api.Project{ID: "p1", Name: "Key Value Store", Language: "go", PageURL: "https://404skill.com/projects/p1"}
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(TestProject)})
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: []api.Project{project}, RequestID: c.fetchID})
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_one"}}
+
+	// Act
+	c, cmd := c.Update(variant.TestCompleteMsg{Variant: &project, Result: result})
+	c = runCmd(c, cmd)
+
+	// Assert
+	if c.CurrentState() != state.TestProject {
+		t.Fatalf("Expected the test results, got %s", c.CurrentState())
+	}
+	if view := c.View(); !strings.Contains(view, "[w] open project page") {
+		t.Errorf("Expected the results to offer the project page, got:\n%s", view)
+	}
+}
+
+func TestController_ShowCachedRunOpensSavedResults(t *testing.T) {
+	// Arrange
+	c := newTestController(t)
+	if err := os.WriteFile(config.ConfigFilePath, []byte("downloaded_projects:\n  p1: true\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	c, _ = c.Update(menu.MenuSelectMsg{SelectedIndex: int(TestProject)})
+	c, _ = c.Update(domain.ProjectsLoadedMsg{Projects: lateProjects, RequestID: c.fetchID})
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	result := &testreport.ParseResult{
+		Suite:       testreport.TestSuite{Name: "Suite", Results: []testreport.TestResult{{Name: "test_saved_one", ClassName: "Task1Test", Passed: true}}},
+		PassedTests: []string{"test_saved_one"},
+	}
+	run := &testreport.StoredRun{ProjectID: "p1", RanAt: time.Date(2026, 1, 2, 15, 4, 0, 0, time.UTC), Result: result, SourceHash: "abc"}
+
+	// Act
+	c, cmd := c.Update(variant.ShowCachedRunMsg{Variant: &lateProjects[0], Run: run})
+	c = runCmd(c, cmd)
+
+	// Assert
+	if c.CurrentState() != state.TestProject {
+		t.Fatalf("Expected the test results, got %s", c.CurrentState())
+	}
+	view := c.View()
+	if !strings.Contains(view, "test_saved_one") || !strings.Contains(view, "Saved results from Jan 2 15:04") {
+		t.Errorf("Expected the saved run's results, got:\n%s", view)
+	}
+}
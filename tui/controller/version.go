@@ -18,6 +18,11 @@ type VersionInfo struct {
 	CheckError      error
 }
 
+// UpdateChecker reports whether a newer CLI version is available
+type UpdateChecker interface {
+	CheckForUpdates(ctx context.Context) VersionInfo
+}
+
 // VersionChecker handles version checking functionality
 type VersionChecker struct {
 	currentVersion string
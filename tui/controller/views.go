@@ -1,6 +1,10 @@
 package controller
 
 import (
+	"fmt"
+	"strings"
+
+	"404skill-cli/tui/components/footer"
 	"404skill-cli/tui/styles"
 
 	"github.com/charmbracelet/lipgloss"
@@ -31,11 +35,75 @@ func (c *Controller) renderMainMenu() string {
 		UpdateAvailable: c.versionInfo.UpdateAvailable,
 		CheckError:      c.versionInfo.CheckError,
 	}) + "\n"
+	view += c.renderAnnouncement()
 	view += c.mainMenu.View()
-	view += "\n" + c.footer.View(c.footerBindings.Navigation()...)
+	view += c.renderIncompleteDownloads()
+	view += "\n" + c.footer.View(c.footerBindings.MainMenu()...)
 	return view
 }
 
+// renderAnnouncement shows the platform announcement as a banner above the main menu
+func (c *Controller) renderAnnouncement() string {
+	if c.announcement == nil {
+		return ""
+	}
+	hint := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true).Render("[d] dismiss")
+	return lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#ffaa00")).
+		Padding(0, 1).
+		Render(c.announcement.Message+"  "+hint) + "\n"
+}
+
+// renderDaySummary shows today's progress under every screen once something has been run
+func (c *Controller) renderDaySummary() string {
+	line := c.daySummary.String()
+	if line == "" {
+		return ""
+	}
+	return "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("#00aa00")).Faint(true).Render(line)
+}
+
+// renderIncompleteDownloads lists downloads interrupted in a previous session and how to deal with them
+func (c *Controller) renderIncompleteDownloads() string {
+	if len(c.incompleteDownloads) == 0 {
+		if c.statusMsg != "" && c.errorMsg == "" {
+			return "\n" + lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true).Render(c.statusMsg)
+		}
+		return c.renderError()
+	}
+
+	// Only interrupted downloads can be cleaned up; a checkout without a marker may be the user's own
+	var interrupted, unrecorded []string
+	for _, download := range c.incompleteDownloads {
+		if download.HasMarker {
+			interrupted = append(interrupted, download.DirName)
+		} else {
+			unrecorded = append(unrecorded, download.DirName)
+		}
+	}
+	warningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#ffaa00")).Bold(true)
+	hintStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true)
+
+	view := ""
+	if len(interrupted) > 0 {
+		view += "\n" + warningStyle.Render("Interrupted download found: "+strings.Join(interrupted, ", ")) +
+			"\n" + hintStyle.Render("Download it again to resume, or press [x] to clean it up.")
+	}
+	if len(c.adoptQueue) > 0 {
+		candidate := c.adoptQueue[0]
+		view += "\n" + warningStyle.Render(fmt.Sprintf("Mark %s as your download of %s?", candidate.DirName, candidate.Project.Name)) +
+			"\n" + hintStyle.Render("[y] yes • [n] skip • [esc] stop")
+	} else if len(unrecorded) > 0 {
+		view += "\n" + warningStyle.Render("Unrecorded project found: "+strings.Join(unrecorded, ", ")) +
+			"\n" + hintStyle.Render("Press [a] if you cloned it yourself.")
+	}
+	if c.statusMsg != "" && c.errorMsg == "" {
+		view += "\n" + hintStyle.Render(c.statusMsg)
+	}
+	return view + c.renderError()
+}
+
 func (c *Controller) renderLogin() string {
 	return c.loginComponent.View()
 }
@@ -50,14 +118,31 @@ func (c *Controller) renderProjectNameMenu() string {
 			Render("\nLoading projects...")
 	}
 
+	title := "Select a project:"
+	if c.downloadedOnly {
+		title = "Select a project (downloaded only):"
+	}
 	header := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#00ffaa")).
 		Bold(true).
 		Underline(true).
 		Padding(0, 1).
-		Render("Select a project:")
+		Render(title)
 
-	return header + "\n" + c.projectNameMenu.View() + "\n" + c.footer.View(c.footerBindings.NavigationWithBack()...)
+	menuView := c.projectNameMenu.View()
+	if c.downloadedOnly && c.projectNameMenu.IsEmpty() {
+		menuView = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true).
+			Render("No downloaded projects yet. Press [d] to show all projects.")
+	}
+
+	filterBinding := footer.DownloadedBinding
+	if c.downloadedOnly {
+		filterBinding.Description = "show all"
+	}
+	sortBinding := footer.SortBinding
+	sortBinding.Description = "sort: " + c.nameMenuSort.String()
+	bindings := append(c.nameMenuBindings(), filterBinding, sortBinding)
+	return header + "\n" + menuView + c.renderError() + "\n" + c.footer.View(bindings...)
 }
 
 func (c *Controller) renderProjectVariantMenu() string {
@@ -67,7 +152,7 @@ func (c *Controller) renderProjectVariantMenu() string {
 		if c.variantComponent.IsDownloading() {
 			return componentView
 		}
-		return componentView + "\n" + c.footer.View(c.footerBindings.NavigationWithBack()...)
+		return componentView + "\n" + c.footer.View(c.footerBindings.DownloadVariant()...)
 	}
 	return "No variants available."
 }
@@ -101,17 +186,52 @@ func (c *Controller) renderTestProjectNameMenu() string {
 		Padding(0, 1).
 		Render("Select a project to test:")
 
-	return header + "\n" + c.testProjectNameMenu.View() + "\n" + c.footer.View(c.footerBindings.NavigationWithBack()...)
+	return header + "\n" + c.testProjectNameMenu.View() + c.renderError() + "\n" + c.footer.View(c.nameMenuBindings()...)
 }
 
 func (c *Controller) renderTestProjectVariantMenu() string {
 	if c.testVariantComponent != nil {
 		componentView := c.testVariantComponent.View()
-		// Don't show footer when testing (component handles its own controls)
-		if c.testVariantComponent.IsTesting() {
+		// Don't show footer when testing, in the history or the log viewer (component handles its own controls)
+		if c.testVariantComponent.IsTesting() || c.testVariantComponent.IsViewingHistory() || c.testVariantComponent.IsViewingLog() {
 			return componentView
 		}
-		return componentView + "\n" + c.footer.View(c.footerBindings.NavigationWithBack()...)
+		return componentView + "\n" + c.footer.View(c.footerBindings.TestVariant()...)
 	}
 	return "No variants available."
 }
+
+func (c *Controller) renderFailingTests() string {
+	if c.failingComponent == nil {
+		return "No failing tests."
+	}
+	return c.failingComponent.View()
+}
+
+// renderError renders the current error, if any, with the result of the last copy attempt
+func (c *Controller) renderError() string {
+	if c.errorMsg == "" {
+		return ""
+	}
+
+	view := "\n" + lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#ff0000")).
+		Bold(true).
+		Render(c.errorMsg)
+	if c.statusMsg != "" {
+		view += "\n" + lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#888888")).
+			Italic(true).
+			Render(c.statusMsg)
+	}
+	return view
+}
+
+// nameMenuBindings returns the footer for project name menus, offering to copy any error
+func (c *Controller) nameMenuBindings() []footer.KeyBinding {
+	bindings := c.footerBindings.NavigationWithBack()
+	if c.errorMsg != "" {
+		bindings = append(bindings, footer.CopyErrorBinding)
+	}
+	return bindings
+}
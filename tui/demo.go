@@ -0,0 +1,38 @@
+package tui
+
+import (
+	"context"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+	"404skill-cli/downloader"
+	"404skill-cli/testrunner"
+	"404skill-cli/tui/controller"
+)
+
+// demoVersionChecker reports the running version as current without going online
+type demoVersionChecker struct {
+	version string
+}
+
+func (d demoVersionChecker) CheckForUpdates(ctx context.Context) controller.VersionInfo {
+	return controller.VersionInfo{CurrentVersion: d.version, LatestVersion: d.version}
+}
+
+// InitialDemoModel creates a TUI model for workshops and screenshots: sample projects, canned
+// test results, no login and no network. Downloads and uploads are simulated; the caller should
+// point the config at a throwaway file, since downloads are still recorded there.
+func InitialDemoModel(version string) (Model, error) {
+	configManager := config.NewConfigManager(nil)
+	ctrl, err := controller.NewWithDependencies(api.NewDemoClient(), version, nil, controller.Dependencies{
+		ConfigManager:  configManager,
+		Downloader:     downloader.NewDemoDownloader(configManager),
+		TestRunner:     testrunner.NewDemoRunner(),
+		VersionChecker: demoVersionChecker{version: version},
+		SkipLogin:      true,
+	})
+	if err != nil {
+		return Model{}, err
+	}
+	return NewModel(ctrl, nil), nil
+}
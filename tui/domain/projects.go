@@ -23,17 +23,21 @@ func NewProjectService(client api.ClientInterface) *ProjectService {
 	}
 }
 
-// FetchProjects fetches projects from the API
-func (s *ProjectService) FetchProjects() tea.Cmd {
+// FetchProjects fetches projects from the API. Cancelling ctx abandons the request and no
+// message is sent; requestID is echoed in the result so callers can drop stale responses.
+func (s *ProjectService) FetchProjects(ctx context.Context, requestID int) tea.Cmd {
 	return func() tea.Msg {
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 		defer cancel()
 
 		projects, err := s.client.ListProjects(ctx)
+		if ctx.Err() == context.Canceled {
+			return nil
+		}
 		if err != nil {
-			return ProjectsErrorMsg{Error: err}
+			return ProjectsErrorMsg{Error: err, RequestID: requestID}
 		}
-		return ProjectsLoadedMsg{Projects: projects}
+		return ProjectsLoadedMsg{Projects: projects, RequestID: requestID}
 	}
 }
 
@@ -117,16 +121,29 @@ func (u *ProjectUtils) CreateTableColumns() []btable.Column {
 	}
 }
 
+// ProjectStatus formats the status column for a project, with a badge counting completed tasks
+func ProjectStatus(downloaded bool, completedTasks int) string {
+	if !downloaded {
+		return ""
+	}
+	if completedTasks > 0 {
+		return fmt.Sprintf("✓ Downloaded ★%d", completedTasks)
+	}
+	return "✓ Downloaded"
+}
+
 // Messages for project domain events
 type (
 	// ProjectsLoadedMsg is sent when projects are successfully loaded
 	ProjectsLoadedMsg struct {
-		Projects []api.Project
+		Projects  []api.Project
+		RequestID int // identifies the fetch, see ProjectService.FetchProjects
 	}
 
 	// ProjectsErrorMsg is sent when there's an error loading projects
 	ProjectsErrorMsg struct {
-		Error error
+		Error     error
+		RequestID int
 	}
 
 	// ProjectSelectedMsg is sent when a project is selected
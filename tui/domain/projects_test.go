@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"404skill-cli/api"
+)
+
+// stubClient returns a fixed project list
+type stubClient struct {
+	projects []api.Project
+}
+
+func (s *stubClient) ListProjects(ctx context.Context) ([]api.Project, error) {
+	return s.projects, nil
+}
+
+func (s *stubClient) InitializeProject(ctx context.Context, projectID string) error {
+	return nil
+}
+
+func (s *stubClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*api.BulkUpdateResponse, error) {
+	return &api.BulkUpdateResponse{}, nil
+}
+
+func TestProjectService_FetchProjects_EchoesRequestID(t *testing.T) {
+	service := NewProjectService(&stubClient{projects: []api.Project{{ID: "p1"}}})
+
+	msg := service.FetchProjects(context.Background(), 7)()
+
+	loaded, ok := msg.(ProjectsLoadedMsg)
+	if !ok {
+		t.Fatalf("Expected ProjectsLoadedMsg, got %T", msg)
+	}
+	if loaded.RequestID != 7 || len(loaded.Projects) != 1 {
+		t.Errorf("Expected request 7 with 1 project, got %+v", loaded)
+	}
+}
+
+func TestProjectService_FetchProjects_CancelledSendsNothing(t *testing.T) {
+	service := NewProjectService(&stubClient{projects: []api.Project{{ID: "p1"}}})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	msg := service.FetchProjects(ctx, 1)()
+
+	if msg != nil {
+		t.Errorf("Expected no message for a cancelled fetch, got %T", msg)
+	}
+}
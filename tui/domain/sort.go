@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"sort"
+
+	"404skill-cli/api"
+)
+
+// CompletionSort orders projects by how many of their tasks are complete
+type CompletionSort int
+
+const (
+	SortDefault       CompletionSort = iota // order returned by the API
+	SortLeastComplete                       // unfinished work first
+	SortMostComplete                        // furthest along first
+)
+
+// Next returns the sort mode that follows s, wrapping back to the default order
+func (s CompletionSort) Next() CompletionSort {
+	return (s + 1) % 3
+}
+
+// String describes the sort mode for help text
+func (s CompletionSort) String() string {
+	switch s {
+	case SortLeastComplete:
+		return "least complete first"
+	case SortMostComplete:
+		return "most complete first"
+	default:
+		return "default order"
+	}
+}
+
+// SortByCompletion returns the projects ordered by progress (e.g. completed tasks).
+// Ties keep their original order, and the input slice is not modified.
+func SortByCompletion(projects []api.Project, progress func(projectID string) int, mode CompletionSort) []api.Project {
+	sorted := make([]api.Project, len(projects))
+	copy(sorted, projects)
+	if mode == SortDefault || progress == nil {
+		return sorted
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, pj := progress(sorted[i].ID), progress(sorted[j].ID)
+		if mode == SortMostComplete {
+			return pi > pj
+		}
+		return pi < pj
+	})
+	return sorted
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+
+	"404skill-cli/api"
+)
+
+func projectIDs(projects []api.Project) []string {
+	ids := make([]string, len(projects))
+	for i, p := range projects {
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestSortByCompletion(t *testing.T) {
+	// Arrange
+	projects := []api.Project{{ID: "journal"}, {ID: "todo"}, {ID: "chat"}, {ID: "shop"}}
+	completed := map[string]int{"journal": 2, "todo": 0, "chat": 5, "shop": 2}
+	progress := func(id string) int { return completed[id] }
+
+	tests := []struct {
+		mode     CompletionSort
+		expected []string
+	}{
+		{SortDefault, []string{"journal", "todo", "chat", "shop"}},
+		{SortLeastComplete, []string{"todo", "journal", "shop", "chat"}},
+		{SortMostComplete, []string{"chat", "journal", "shop", "todo"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			// Act
+			sorted := SortByCompletion(projects, progress, tt.mode)
+
+			// Assert
+			got := projectIDs(sorted)
+			for i := range tt.expected {
+				if got[i] != tt.expected[i] {
+					t.Fatalf("Expected %v, got %v", tt.expected, got)
+				}
+			}
+		})
+	}
+
+	if projects[0].ID != "journal" || projects[2].ID != "chat" {
+		t.Error("Expected the input slice to be left in its original order")
+	}
+}
+
+func TestCompletionSort_Next(t *testing.T) {
+	if SortDefault.Next() != SortLeastComplete || SortLeastComplete.Next() != SortMostComplete || SortMostComplete.Next() != SortDefault {
+		t.Error("Expected sort modes to cycle default -> least -> most -> default")
+	}
+}
@@ -0,0 +1,31 @@
+package failing
+
+import "404skill-cli/testreport"
+
+// ProjectFailures is the failing tests of one project's latest run
+type ProjectFailures struct {
+	Run    testreport.StoredRun
+	Failed []testreport.TestResult
+}
+
+// Aggregate collects the failing tests of each downloaded project's latest run, keeping the
+// order of runs. Excluded tests and projects without failures are left out.
+func Aggregate(runs []testreport.StoredRun, downloaded map[string]bool, excluded []string) []ProjectFailures {
+	var failures []ProjectFailures
+	for _, run := range runs {
+		if !downloaded[run.ProjectID] || run.Result == nil {
+			continue
+		}
+
+		var failed []testreport.TestResult
+		for _, test := range run.Result.Suite.Results {
+			if !test.Passed && !testreport.IsExcluded(test, excluded) {
+				failed = append(failed, test)
+			}
+		}
+		if len(failed) > 0 {
+			failures = append(failures, ProjectFailures{Run: run, Failed: failed})
+		}
+	}
+	return failures
+}
@@ -0,0 +1,89 @@
+package failing
+
+import (
+	"testing"
+	"time"
+
+	"404skill-cli/testreport"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// storedRun builds a saved run with the given passing and failing tests
+func storedRun(id, name string, passed, failed []string) testreport.StoredRun {
+	suite := testreport.TestSuite{Name: name}
+	for _, test := range passed {
+		suite.Results = append(suite.Results, testreport.TestResult{Name: test, ClassName: "TestTask1", Passed: true})
+	}
+	for _, test := range failed {
+		suite.Results = append(suite.Results, testreport.TestResult{Name: test, ClassName: "TestTask2", Failure: &testreport.TestFailure{Message: "boom"}})
+	}
+	return testreport.StoredRun{
+		ProjectID:   id,
+		ProjectName: name,
+		Language:    "go",
+		RanAt:       time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Result:      &testreport.ParseResult{Suite: suite},
+	}
+}
+
+func TestAggregate_FromSavedRunsOfSeveralProjects(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	for _, run := range []testreport.StoredRun{
+		storedRun("3", "Rate Limiter", nil, []string{"test_refill", "test_burst"}),
+		storedRun("1", "Journal API", []string{"test_health"}, []string{"test_create"}),
+		storedRun("2", "Key Value Store", []string{"test_get", "test_set"}, nil),
+		storedRun("4", "Deleted Project", nil, []string{"test_gone"}),
+	} {
+		if err := testreport.SaveLastRun(dir, run); err != nil {
+			t.Fatalf("Failed to save run: %v", err)
+		}
+	}
+	runs, err := testreport.LoadLastRuns(dir)
+	if err != nil {
+		t.Fatalf("Failed to load runs: %v", err)
+	}
+	downloaded := map[string]bool{"1": true, "2": true, "3": true}
+
+	// Act
+	failures := Aggregate(runs, downloaded, []string{"test_burst"})
+
+	// Assert
+	if len(failures) != 2 {
+		t.Fatalf("Expected 2 projects with failures, got %d: %+v", len(failures), failures)
+	}
+	if failures[0].Run.ProjectName != "Journal API" || failures[1].Run.ProjectName != "Rate Limiter" {
+		t.Errorf("Expected projects in name order, got %s, %s", failures[0].Run.ProjectName, failures[1].Run.ProjectName)
+	}
+	if len(failures[0].Failed) != 1 || failures[0].Failed[0].Name != "test_create" {
+		t.Errorf("Expected only test_create failing for Journal API, got %+v", failures[0].Failed)
+	}
+	if len(failures[1].Failed) != 1 || failures[1].Failed[0].Name != "test_refill" {
+		t.Errorf("Expected excluded tests to be left out, got %+v", failures[1].Failed)
+	}
+}
+
+func TestComponent_EnterOpensSelectedProjectResults(t *testing.T) {
+	// Arrange
+	c := New(Aggregate([]testreport.StoredRun{
+		storedRun("1", "Journal API", nil, []string{"test_create"}),
+		storedRun("3", "Rate Limiter", nil, []string{"test_refill"}),
+	}, map[string]bool{"1": true, "3": true}, nil))
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyDown})
+	_, cmd := c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected a command opening the results")
+	}
+	msg, ok := cmd().(OpenResultsMsg)
+	if !ok {
+		t.Fatalf("Expected OpenResultsMsg, got %T", cmd())
+	}
+	if msg.Run.ProjectID != "3" {
+		t.Errorf("Expected the second project's results, got project %s", msg.Run.ProjectID)
+	}
+}
@@ -0,0 +1,130 @@
+package failing
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	headerStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00ffaa")).
+			Underline(true).
+			Padding(0, 1)
+
+	projectStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#ffffff"))
+	failedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	selectedStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#00ff00"))
+	timeStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("#cccccc"))
+
+	helpStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#666666")).
+			Faint(true)
+)
+
+// entry is one failing test in the flattened list the cursor moves over
+type entry struct {
+	project int
+	test    int
+}
+
+// Component lists the tests currently failing across projects, grouped by project
+type Component struct {
+	projects []ProjectFailures
+	entries  []entry
+	cursor   int
+}
+
+// New creates the failing tests view from aggregated project failures
+func New(projects []ProjectFailures) *Component {
+	c := &Component{projects: projects}
+	for p, project := range projects {
+		for t := range project.Failed {
+			c.entries = append(c.entries, entry{project: p, test: t})
+		}
+	}
+	return c
+}
+
+// Selected returns the project of the highlighted test, or nil when nothing is failing
+func (c *Component) Selected() *ProjectFailures {
+	if c.cursor < 0 || c.cursor >= len(c.entries) {
+		return nil
+	}
+	return &c.projects[c.entries[c.cursor].project]
+}
+
+// Update moves through the failing tests and opens the highlighted project's results
+func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return c, nil
+	}
+
+	switch keyMsg.String() {
+	case "up", "k":
+		if c.cursor > 0 {
+			c.cursor--
+		}
+	case "down", "j":
+		if c.cursor < len(c.entries)-1 {
+			c.cursor++
+		}
+	case "enter":
+		if selected := c.Selected(); selected != nil {
+			run := selected.Run
+			return c, func() tea.Msg { return OpenResultsMsg{Run: run} }
+		}
+	case "esc", "b":
+		return c, func() tea.Msg { return CloseMsg{} }
+	}
+	return c, nil
+}
+
+// View renders the failing tests grouped by project
+func (c *Component) View() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Failing Tests"))
+	b.WriteString("\n\n")
+
+	if len(c.projects) == 0 {
+		b.WriteString("No failing tests in the latest runs of your projects.\n")
+	}
+
+	i := 0
+	for _, project := range c.projects {
+		title := project.Run.ProjectName
+		if project.Run.Language != "" {
+			title += " (" + project.Run.Language + ")"
+		}
+		b.WriteString(projectStyle.Render(title))
+		b.WriteString("  " + timeStyle.Render(fmt.Sprintf("%d failing • ran %s", len(project.Failed), project.Run.RanAt.Local().Format("2006-01-02 15:04"))))
+		b.WriteString("\n")
+
+		for _, test := range project.Failed {
+			line := "    ✗ " + testName(test.ClassName, test.Name)
+			if i == c.cursor {
+				b.WriteString(selectedStyle.Render("  > " + strings.TrimLeft(line, " ")))
+			} else {
+				b.WriteString(failedStyle.Render(line))
+			}
+			b.WriteString("\n")
+			i++
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(helpStyle.Render("[↑/↓] move • [enter] open results • [esc/b] back • [q] quit"))
+	return b.String()
+}
+
+// testName shows the class alongside the test so same-named tests can be told apart
+func testName(className, name string) string {
+	if className == "" {
+		return name
+	}
+	return className + " › " + name
+}
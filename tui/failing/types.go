@@ -0,0 +1,11 @@
+package failing
+
+import "404skill-cli/testreport"
+
+// OpenResultsMsg is sent when the user picks a project to see its full results
+type OpenResultsMsg struct {
+	Run testreport.StoredRun
+}
+
+// CloseMsg is sent when the user leaves the failing tests view
+type CloseMsg struct{}
@@ -0,0 +1,137 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"404skill-cli/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	headerStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00ffaa")).
+			Underline(true).
+			Padding(0, 1)
+
+	passedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#00ff00"))
+	failedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	timeStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#cccccc"))
+
+	helpStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#666666")).
+			Faint(true)
+)
+
+// absoluteTimeFormat is used when relative times are turned off
+const absoluteTimeFormat = "2006-01-02 15:04"
+
+// Component lists a project's recent test runs, newest first
+type Component struct {
+	projectName string
+	runs        []config.RunRecord
+	relative    bool
+	now         func() time.Time
+}
+
+// New creates a history view; runs are expected oldest first, as persisted
+func New(projectName string, runs []config.RunRecord, relative bool) *Component {
+	return &Component{
+		projectName: projectName,
+		runs:        runs,
+		relative:    relative,
+		now:         time.Now,
+	}
+}
+
+// IsRelative reports whether times are shown relative to now
+func (c *Component) IsRelative() bool {
+	return c.relative
+}
+
+// Update handles toggling the time format and closing the view
+func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return c, nil
+	}
+
+	switch keyMsg.String() {
+	case "t":
+		c.relative = !c.relative
+		relative := c.relative
+		return c, func() tea.Msg { return TimeFormatToggledMsg{Relative: relative} }
+	case "esc", "b":
+		return c, func() tea.Msg { return CloseMsg{} }
+	case "q", "ctrl+c":
+		return c, tea.Quit
+	}
+	return c, nil
+}
+
+// View renders the run history
+func (c *Component) View() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Run History: " + c.projectName))
+	b.WriteString("\n\n")
+
+	if len(c.runs) == 0 {
+		b.WriteString("No test runs recorded yet.\n")
+	}
+
+	now := c.now()
+	for i := len(c.runs) - 1; i >= 0; i-- {
+		b.WriteString(c.formatRun(c.runs[i], now))
+		b.WriteString("\n")
+	}
+
+	format := "relative"
+	if c.relative {
+		format = "absolute"
+	}
+	b.WriteString("\n" + helpStyle.Render(fmt.Sprintf("[t] %s times • [esc/b] back • [q] quit", format)))
+	return b.String()
+}
+
+// formatRun renders one run as its time followed by the pass/fail counts
+func (c *Component) formatRun(run config.RunRecord, now time.Time) string {
+	when := run.Time.Local().Format(absoluteTimeFormat)
+	if c.relative {
+		when = humanizeTime(run.Time, now)
+	}
+
+	return fmt.Sprintf("%-18s  %s  %s  (%.2fs)",
+		timeStyle.Render(when),
+		passedStyle.Render(fmt.Sprintf("%d passed", run.Passed)),
+		failedStyle.Render(fmt.Sprintf("%d failed", run.Failed)),
+		run.Duration)
+}
+
+// humanizeTime describes t relative to now, e.g. "just now", "5 minutes ago", "2 days ago".
+// Anything older than a month falls back to the date.
+func humanizeTime(t, now time.Time) string {
+	elapsed := now.Sub(t)
+	switch {
+	case elapsed < time.Minute:
+		return "just now"
+	case elapsed < time.Hour:
+		return plural(int(elapsed/time.Minute), "minute") + " ago"
+	case elapsed < 24*time.Hour:
+		return plural(int(elapsed/time.Hour), "hour") + " ago"
+	case elapsed < 30*24*time.Hour:
+		return plural(int(elapsed/(24*time.Hour)), "day") + " ago"
+	default:
+		return t.Local().Format("2006-01-02")
+	}
+}
+
+func plural(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
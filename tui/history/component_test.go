@@ -0,0 +1,74 @@
+package history
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"404skill-cli/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHumanizeTime(t *testing.T) {
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		ago      time.Duration
+		expected string
+	}{
+		{"just now", 20 * time.Second, "just now"},
+		{"in the future", -5 * time.Minute, "just now"},
+		{"one minute", time.Minute, "1 minute ago"},
+		{"minutes", 42 * time.Minute, "42 minutes ago"},
+		{"one hour", 90 * time.Minute, "1 hour ago"},
+		{"hours", 5 * time.Hour, "5 hours ago"},
+		{"one day", 30 * time.Hour, "1 day ago"},
+		{"days", 6 * 24 * time.Hour, "6 days ago"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := humanizeTime(now.Add(-tt.ago), now); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHumanizeTime_OlderThanAMonthShowsDate(t *testing.T) {
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.Local)
+	then := time.Date(2024, 1, 5, 12, 0, 0, 0, time.Local)
+
+	if got := humanizeTime(then, now); got != "2024-01-05" {
+		t.Errorf("Expected the date for old runs, got %q", got)
+	}
+}
+
+func TestComponent_ToggleTimeFormat(t *testing.T) {
+	// Arrange
+	now := time.Date(2024, 3, 20, 12, 0, 0, 0, time.Local)
+	runs := []config.RunRecord{{Time: now.Add(-2 * time.Hour), Passed: 3, Failed: 1, Duration: 1.5}}
+	component := New("Journal", runs, false)
+	component.now = func() time.Time { return now }
+
+	// Act
+	absolute := component.View()
+	component, cmd := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	relative := component.View()
+
+	// Assert
+	if !strings.Contains(absolute, "2024-03-20 10:00") {
+		t.Errorf("Expected an absolute timestamp, got:\n%s", absolute)
+	}
+	if !strings.Contains(relative, "2 hours ago") {
+		t.Errorf("Expected a relative time after toggling, got:\n%s", relative)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a command reporting the new preference")
+	}
+	if msg, ok := cmd().(TimeFormatToggledMsg); !ok || !msg.Relative {
+		t.Errorf("Expected TimeFormatToggledMsg{Relative: true}, got %#v", msg)
+	}
+}
@@ -0,0 +1,61 @@
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"404skill-cli/config"
+)
+
+// DaySummary totals the test runs of one day across every project
+type DaySummary struct {
+	Runs         int
+	NewlyPassing int // increase in passing tests over each project's last run before the day
+}
+
+// SummarizeDay totals the runs recorded on the same calendar day as day. A project's newly passing
+// tests are how far its best pass count of the day rose above its last run before the day, so tests
+// that flip between passing and failing are counted once. Without an earlier run, because it is the
+// project's first day or the history was trimmed, the day is compared with its own first run.
+func SummarizeDay(runHistory map[string][]config.RunRecord, day time.Time) DaySummary {
+	year, month, date := day.Date()
+	start := time.Date(year, month, date, 0, 0, 0, 0, day.Location())
+	end := start.AddDate(0, 0, 1)
+
+	var summary DaySummary
+	for _, runs := range runHistory {
+		var baseline *config.RunRecord
+		bestPassed, runsToday := 0, 0
+		for i, run := range runs {
+			if run.Time.Before(start) {
+				baseline = &runs[i]
+				continue
+			}
+			if !run.Time.Before(end) {
+				continue
+			}
+			if baseline == nil {
+				baseline = &runs[i]
+			}
+			runsToday++
+			bestPassed = max(bestPassed, run.Passed)
+		}
+		if runsToday == 0 {
+			continue
+		}
+		summary.Runs += runsToday
+		if gained := bestPassed - baseline.Passed; gained > 0 {
+			summary.NewlyPassing += gained
+		}
+	}
+	return summary
+}
+
+// String renders the summary as a footer line, e.g. "Today: 3 runs, 12 tests newly passing";
+// it is empty until the first run of the day
+func (s DaySummary) String() string {
+	if s.Runs == 0 {
+		return ""
+	}
+	return fmt.Sprintf("Today: %s, %s newly passing", plural(s.Runs, "run"), plural(s.NewlyPassing, "test"))
+}
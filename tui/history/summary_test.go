@@ -0,0 +1,111 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"404skill-cli/config"
+)
+
+func TestSummarizeDay(t *testing.T) {
+	// Arrange
+	today := time.Date(2024, 3, 20, 15, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	runHistory := map[string][]config.RunRecord{
+		// 4 passing yesterday, then 6 and 5 today: 2 newly passing
+		"1": {
+			{Time: yesterday, Passed: 4, Failed: 6},
+			{Time: today.Add(-3 * time.Hour), Passed: 6, Failed: 4},
+			{Time: today.Add(-time.Hour), Passed: 5, Failed: 5},
+		},
+		// First run ever: nothing to compare with
+		"2": {
+			{Time: today.Add(-2 * time.Hour), Passed: 3, Failed: 1},
+		},
+		// Only run yesterday: not counted
+		"3": {
+			{Time: yesterday, Passed: 10},
+		},
+		// Just after midnight tomorrow: not counted
+		"4": {
+			{Time: time.Date(2024, 3, 21, 0, 0, 1, 0, time.UTC), Passed: 7},
+		},
+	}
+
+	// Act
+	summary := SummarizeDay(runHistory, today)
+
+	// Assert
+	if summary.Runs != 3 {
+		t.Errorf("Expected 3 runs today, got %d", summary.Runs)
+	}
+	if summary.NewlyPassing != 2 {
+		t.Errorf("Expected 2 newly passing tests, got %d", summary.NewlyPassing)
+	}
+	if got := summary.String(); got != "Today: 3 runs, 2 tests newly passing" {
+		t.Errorf("Unexpected summary line %q", got)
+	}
+}
+
+func TestSummarizeDay_FlipFloppingTests(t *testing.T) {
+	// Arrange: the same two tests pass, fail and pass again
+	today := time.Date(2024, 3, 20, 15, 0, 0, 0, time.UTC)
+	runHistory := map[string][]config.RunRecord{
+		"1": {
+			{Time: today.AddDate(0, 0, -1), Passed: 4, Failed: 2},
+			{Time: today.Add(-4 * time.Hour), Passed: 6},
+			{Time: today.Add(-3 * time.Hour), Passed: 4, Failed: 2},
+			{Time: today.Add(-2 * time.Hour), Passed: 6},
+			{Time: today.Add(-time.Hour), Passed: 4, Failed: 2},
+		},
+	}
+
+	// Act
+	summary := SummarizeDay(runHistory, today)
+
+	// Assert
+	if summary.Runs != 4 {
+		t.Errorf("Expected 4 runs today, got %d", summary.Runs)
+	}
+	if summary.NewlyPassing != 2 {
+		t.Errorf("Expected the 2 flip-flopping tests to count once, got %d", summary.NewlyPassing)
+	}
+}
+
+func TestSummarizeDay_TrimmedHistory(t *testing.T) {
+	// Arrange: a busy day that pushed every earlier run out of the history
+	today := time.Date(2024, 3, 20, 23, 0, 0, 0, time.UTC)
+	var runs []config.RunRecord
+	for i := 0; i < config.MaxRunHistory; i++ {
+		runs = append(runs, config.RunRecord{Time: today.Add(time.Duration(i-config.MaxRunHistory) * time.Minute), Passed: 30 + i/10})
+	}
+	runHistory := map[string][]config.RunRecord{"1": runs}
+
+	// Act
+	summary := SummarizeDay(runHistory, today)
+
+	// Assert
+	if summary.Runs != config.MaxRunHistory {
+		t.Errorf("Expected %d runs today, got %d", config.MaxRunHistory, summary.Runs)
+	}
+	if summary.NewlyPassing != 1 {
+		t.Errorf("Expected only the gain over the oldest kept run, got %d", summary.NewlyPassing)
+	}
+}
+
+func TestDaySummary_String(t *testing.T) {
+	tests := []struct {
+		summary  DaySummary
+		expected string
+	}{
+		{DaySummary{}, ""},
+		{DaySummary{Runs: 1, NewlyPassing: 1}, "Today: 1 run, 1 test newly passing"},
+		{DaySummary{Runs: 2}, "Today: 2 runs, 0 tests newly passing"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.summary.String(); got != tt.expected {
+			t.Errorf("Expected %q, got %q", tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package history
+
+// CloseMsg is sent when the user leaves the history view
+type CloseMsg struct{}
+
+// TimeFormatToggledMsg is sent when the user switches between absolute and relative times,
+// so the owner can persist the preference
+type TimeFormatToggledMsg struct {
+	Relative bool
+}
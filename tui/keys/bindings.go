@@ -115,6 +115,16 @@ func (f *FooterBindings) Navigation() []footer.KeyBinding {
 	}
 }
 
+// MainMenu returns bindings for the main menu
+func (f *FooterBindings) MainMenu() []footer.KeyBinding {
+	return []footer.KeyBinding{
+		footer.NavigateBinding,
+		footer.EnterBinding,
+		footer.BundleBinding,
+		footer.QuitBinding,
+	}
+}
+
 // NavigationWithBack returns bindings for navigation contexts with back option
 func (f *FooterBindings) NavigationWithBack() []footer.KeyBinding {
 	return []footer.KeyBinding{
@@ -125,6 +135,38 @@ func (f *FooterBindings) NavigationWithBack() []footer.KeyBinding {
 	}
 }
 
+// DownloadVariant returns bindings for picking a variant to download
+func (f *FooterBindings) DownloadVariant() []footer.KeyBinding {
+	return []footer.KeyBinding{
+		footer.NavigateBinding,
+		footer.EnterBinding,
+		footer.OpenAfterBinding,
+		footer.EditorBinding,
+		footer.PageBinding,
+		footer.SnippetBinding,
+		footer.ColorsBinding,
+		footer.BackBinding,
+		footer.QuitBinding,
+	}
+}
+
+// TestVariant returns bindings for picking a variant to test
+func (f *FooterBindings) TestVariant() []footer.KeyBinding {
+	return []footer.KeyBinding{
+		footer.NavigateBinding,
+		footer.EnterBinding,
+		footer.ValidateBinding,
+		footer.FastBinding,
+		footer.EditorBinding,
+		footer.PageBinding,
+		footer.SnippetBinding,
+		footer.HistoryBinding,
+		footer.ColorsBinding,
+		footer.BackBinding,
+		footer.QuitBinding,
+	}
+}
+
 // Login returns bindings for login context
 func (f *FooterBindings) Login() []footer.KeyBinding {
 	return []footer.KeyBinding{
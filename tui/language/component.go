@@ -133,6 +133,9 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 		c.downloading = false
 		c.progress = 1.0
 		c.currentOperation = "Download complete!"
+		if msg.Warning != "" {
+			c.currentOperation += " " + msg.Warning
+		}
 		return c, nil
 	case DownloadErrorMsg:
 		c.SetError(msg.Error)
@@ -156,15 +159,24 @@ func (c *Component) startDownload(language string) tea.Cmd {
 		// Set initial operation
 		c.SetCurrentOperation("Preparing download...")
 
-		err := c.downloader.DownloadProject(ctx, c.project, language, progressCallback)
-		if err != nil {
+		var err error
+		if streaming, ok := c.downloader.(downloader.OutputDownloader); ok {
+			err = streaming.DownloadProjectWithOutput(ctx, c.project, language, progressCallback, c.SetCurrentOperation)
+		} else {
+			err = c.downloader.DownloadProject(ctx, c.project, language, progressCallback)
+		}
+		if err != nil && !downloader.IsWarning(err) {
 			return DownloadErrorMsg{Error: err.Error()}
 		}
 
-		return DownloadCompleteMsg{
+		msg := DownloadCompleteMsg{
 			Project:  c.project,
 			Language: language,
 		}
+		if err != nil {
+			msg.Warning = err.Error()
+		}
+		return msg
 	}
 }
 
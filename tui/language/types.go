@@ -13,6 +13,7 @@ type DownloadCompleteMsg struct {
 	Project   *api.Project
 	Language  string
 	Directory string
+	Warning   string // set when the download succeeded but registering the project did not
 }
 
 // DownloadProgressMsg contains the current progress of the download operation
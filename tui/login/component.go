@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"404skill-cli/auth"
 	"404skill-cli/tracing"
@@ -23,8 +24,24 @@ type Component struct {
 	authService *auth.AuthService
 	footer      *footer.Component
 	tracer      *tracing.TUIIntegration
+
+	// Cooldown after repeated failures, so a mistyped password isn't retried against the backend in a loop
+	failedAttempts int
+	cooldownUntil  time.Time
+	now            func() time.Time
 }
 
+const (
+	// freeLoginAttempts is how many consecutive failures are allowed before a cooldown starts
+	freeLoginAttempts = 3
+	// baseLoginCooldown is the first cooldown; each further failure doubles it up to maxLoginCooldown
+	baseLoginCooldown = 5 * time.Second
+	maxLoginCooldown  = 5 * time.Minute
+)
+
+// cooldownTickMsg refreshes the countdown while submission is disabled
+type cooldownTickMsg struct{}
+
 // New creates a new login component with dependency injection
 func New(authProvider auth.AuthProvider, configWriter auth.ConfigWriter) *Component {
 	// Get tracing integration from global manager
@@ -52,6 +69,7 @@ func New(authProvider auth.AuthProvider, configWriter auth.ConfigWriter) *Compon
 		authService: auth.NewAuthService(authProvider, configWriter),
 		footer:      footer.New(),
 		tracer:      tuiTracer,
+		now:         time.Now,
 	}
 
 	// Track component initialization
@@ -94,6 +112,9 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 			if c.tracer != nil {
 				_ = c.tracer.TrackKeyMsg(msg, "login_submit_attempt")
 			}
+			if c.focusIdx == 1 && !c.loggingIn && c.cooldownRemaining() > 0 {
+				return c, nil // the countdown is already shown
+			}
 			if c.focusIdx == 1 && !c.loggingIn {
 				if c.tracer != nil {
 					_ = c.tracer.TrackProjectOperation("login_attempt", "authentication")
@@ -124,6 +145,8 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 		}
 		c.errorMsg = ""
 		c.loggingIn = false
+		c.failedAttempts = 0
+		c.cooldownUntil = time.Time{}
 		return c, LoginSuccessCommand()
 	case LoginErrorMsg:
 		if c.tracer != nil {
@@ -131,12 +154,54 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 		}
 		c.errorMsg = msg.Error
 		c.loggingIn = false
+		c.failedAttempts++
+		if cooldown := loginCooldown(c.failedAttempts); cooldown > 0 {
+			c.cooldownUntil = c.now().Add(cooldown)
+			return c, cooldownTick()
+		}
+		return c, nil
+	case cooldownTickMsg:
+		if c.cooldownRemaining() > 0 {
+			return c, cooldownTick()
+		}
 		return c, nil
 	}
 
 	return c, nil
 }
 
+// loginCooldown returns how long submission is disabled after the given number of consecutive failures
+func loginCooldown(failures int) time.Duration {
+	if failures < freeLoginAttempts {
+		return 0
+	}
+	cooldown := baseLoginCooldown
+	for i := freeLoginAttempts; i < failures; i++ {
+		cooldown *= 2
+		if cooldown >= maxLoginCooldown {
+			return maxLoginCooldown
+		}
+	}
+	return cooldown
+}
+
+// cooldownRemaining returns how long until another login attempt is allowed
+func (c *Component) cooldownRemaining() time.Duration {
+	if c.cooldownUntil.IsZero() {
+		return 0
+	}
+	remaining := c.cooldownUntil.Sub(c.now())
+	if remaining <= 0 {
+		return 0
+	}
+	return remaining
+}
+
+// cooldownTick schedules the next countdown refresh
+func cooldownTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return cooldownTickMsg{} })
+}
+
 // GetUsername returns the current username input
 func (c *Component) GetUsername() string {
 	return c.inputs[0].Value()
@@ -191,6 +256,10 @@ func (c *Component) View() string {
 	if c.errorMsg != "" {
 		content += "\n" + errorStyle.Render(c.errorMsg)
 	}
+	if remaining := c.cooldownRemaining(); remaining > 0 {
+		seconds := int((remaining + time.Second - 1) / time.Second)
+		content += "\n" + headerStyle.Render(fmt.Sprintf("Too many attempts. Retry in %ds.", seconds))
+	}
 	if c.loggingIn {
 		content += "\n" + headerStyle.Render("Logging in...")
 	}
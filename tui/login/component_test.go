@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -258,3 +259,93 @@ func TestComponent_View_ShowsLoggingIn(t *testing.T) {
 		t.Error("Expected view to contain 'Logging in...' message")
 	}
 }
+
+// fakeClock is a settable clock for the login cooldown
+type fakeClock struct {
+	now time.Time
+}
+
+func (f *fakeClock) Now() time.Time {
+	return f.now
+}
+
+func TestLoginCooldown_Escalation(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{1, 0},
+		{2, 0},
+		{3, 5 * time.Second},
+		{4, 10 * time.Second},
+		{5, 20 * time.Second},
+		{10, 5 * time.Minute}, // 640s, capped
+		{50, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := loginCooldown(tt.failures); got != tt.expected {
+			t.Errorf("loginCooldown(%d) = %v, expected %v", tt.failures, got, tt.expected)
+		}
+	}
+}
+
+func TestComponent_CooldownBlocksSubmitUntilElapsed(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{now: time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)}
+	component := New(&MockAuthProvider{}, &MockConfigWriter{})
+	component.now = clock.Now
+	component.focusIdx = 1
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	// Act: three failures in a row start a cooldown
+	for i := 0; i < freeLoginAttempts; i++ {
+		component.Update(LoginErrorMsg{Error: "invalid credentials"})
+	}
+	_, blockedCmd := component.Update(enter)
+
+	// Assert
+	if blockedCmd != nil || component.loggingIn {
+		t.Error("Expected submission to be disabled during the cooldown")
+	}
+	if view := component.View(); !strings.Contains(view, "Retry in 5s") {
+		t.Errorf("Expected a countdown in the view, got:\n%s", view)
+	}
+
+	clock.now = clock.now.Add(3 * time.Second)
+	if view := component.View(); !strings.Contains(view, "Retry in 2s") {
+		t.Errorf("Expected the countdown to tick down, got:\n%s", view)
+	}
+
+	clock.now = clock.now.Add(2 * time.Second)
+	_, cmd := component.Update(enter)
+	if cmd == nil || !component.loggingIn {
+		t.Error("Expected submission to be allowed once the cooldown elapsed")
+	}
+}
+
+func TestComponent_CooldownEscalatesAndResetsOnSuccess(t *testing.T) {
+	// Arrange
+	clock := &fakeClock{now: time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)}
+	component := New(&MockAuthProvider{}, &MockConfigWriter{})
+	component.now = clock.Now
+	for i := 0; i < freeLoginAttempts+1; i++ {
+		component.Update(LoginErrorMsg{Error: "invalid credentials"})
+	}
+	if remaining := component.cooldownRemaining(); remaining != 10*time.Second {
+		t.Fatalf("Expected the fourth failure to double the cooldown to 10s, got %v", remaining)
+	}
+
+	// Act
+	component.Update(LoginSuccessMsg{})
+
+	// Assert
+	if component.failedAttempts != 0 || component.cooldownRemaining() != 0 {
+		t.Errorf("Expected success to reset the cooldown, got %d failures and %v remaining",
+			component.failedAttempts, component.cooldownRemaining())
+	}
+	component.Update(LoginErrorMsg{Error: "invalid credentials"})
+	if component.cooldownRemaining() != 0 {
+		t.Error("Expected the next failure after a success to be free again")
+	}
+}
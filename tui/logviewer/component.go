@@ -0,0 +1,171 @@
+package logviewer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"404skill-cli/testrunner"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Styles for each kind of log line
+var (
+	headerStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00ffaa")).
+			Underline(true).
+			Padding(0, 1)
+
+	metaStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#00aaff"))
+	noiseStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#666666"))
+	taskStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("#ffaa00")).Bold(true)
+	successStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#00aa00")).Bold(true)
+	errorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff5555"))
+	stderrStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	normalStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#cccccc"))
+
+	helpStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#666666")).
+			Faint(true)
+)
+
+// Prefixes the test runner writes in front of each captured output line
+const (
+	stdoutPrefix = "STDOUT: "
+	stderrPrefix = "STDERR: "
+)
+
+// Component renders a saved test run log in a scrollable viewport
+type Component struct {
+	viewport  viewport.Model
+	logFilter *testrunner.LogFilter
+	path      string
+	lineCount int
+}
+
+// New creates a log viewer with the given size
+func New(width, height int) *Component {
+	c := &Component{
+		viewport:  viewport.New(width, height),
+		logFilter: testrunner.NewLogFilter(),
+	}
+	c.SetSize(width, height)
+	return c
+}
+
+// Load reads the log file and renders its colored contents into the viewport
+func (c *Component) Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read log file: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	rendered := make([]string, len(lines))
+	for i, line := range lines {
+		rendered[i] = c.renderLine(line)
+	}
+
+	c.path = path
+	c.lineCount = len(lines)
+	c.viewport.SetContent(strings.Join(rendered, "\n"))
+	c.viewport.GotoTop()
+	return nil
+}
+
+// SetSize resizes the viewport, reserving room for the header and help line
+func (c *Component) SetSize(width, height int) {
+	c.viewport.Width = width
+	c.viewport.Height = max(height-4, 1)
+}
+
+// ClassifyLine determines how a log line should be colored, reusing the runner's log filter
+func (c *Component) ClassifyLine(line string) LineKind {
+	if strings.HasPrefix(line, stderrPrefix) {
+		return LineStderr
+	}
+	if !strings.HasPrefix(line, stdoutPrefix) {
+		if strings.TrimSpace(line) == "" {
+			return LineNormal
+		}
+		return LineMeta
+	}
+
+	filtered := c.logFilter.FilterMessage(strings.TrimPrefix(line, stdoutPrefix), testrunner.FilterNone)
+	switch filtered.Level {
+	case testrunner.LevelNoise:
+		return LineNoise
+	case testrunner.LevelTask:
+		return LineTask
+	case testrunner.LevelSuccess:
+		return LineSuccess
+	case testrunner.LevelError:
+		return LineError
+	default:
+		return LineNormal
+	}
+}
+
+// renderLine colors a single log line according to its kind
+func (c *Component) renderLine(line string) string {
+	return styleFor(c.ClassifyLine(line)).Render(line)
+}
+
+// styleFor returns the style used for a kind of log line
+func styleFor(kind LineKind) lipgloss.Style {
+	switch kind {
+	case LineMeta:
+		return metaStyle
+	case LineNoise:
+		return noiseStyle
+	case LineTask:
+		return taskStyle
+	case LineSuccess:
+		return successStyle
+	case LineError:
+		return errorStyle
+	case LineStderr:
+		return stderrStyle
+	default:
+		return normalStyle
+	}
+}
+
+// Update handles scrolling and closing the viewer
+func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		c.SetSize(msg.Width, msg.Height)
+		return c, nil
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "b":
+			return c, func() tea.Msg { return CloseMsg{} }
+		case "q", "ctrl+c":
+			return c, tea.Quit
+		case "g", "home":
+			c.viewport.GotoTop()
+			return c, nil
+		case "G", "end":
+			c.viewport.GotoBottom()
+			return c, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	c.viewport, cmd = c.viewport.Update(msg)
+	return c, cmd
+}
+
+// View renders the log viewer
+func (c *Component) View() string {
+	header := headerStyle.Render("Test Log: " + filepath.Base(c.path))
+	help := helpStyle.Render(fmt.Sprintf("%d lines • %3.f%% • ↑/↓ scroll • pgup/pgdn page • g/G top/bottom • esc/b back • q quit",
+		c.lineCount, c.viewport.ScrollPercent()*100))
+	return fmt.Sprintf("%s\n\n%s\n%s", header, c.viewport.View(), help)
+}
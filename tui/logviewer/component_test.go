@@ -0,0 +1,112 @@
+package logviewer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const sampleLog = `Test run started at: 2025-01-01T10:00:00Z
+Command: docker compose -f docker-compose.test.yml up --build --abort-on-container-exit
+
+=== OUTPUT ===
+STDOUT: #5 CACHED
+STDOUT: app-1  | > Task :test
+STDOUT: app-1  | BUILD SUCCESSFUL in 3s
+STDERR: app-1  | some warning on stderr
+STDOUT: app-1  | plain output line
+`
+
+func writeSampleLog(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test-run_java_2025-01-01_10-00-00.log")
+	if err := os.WriteFile(path, []byte(sampleLog), 0644); err != nil {
+		t.Fatalf("Failed to write log file: %v", err)
+	}
+	return path
+}
+
+func TestComponent_ClassifyLine(t *testing.T) {
+	viewer := New(80, 20)
+
+	tests := []struct {
+		line     string
+		expected LineKind
+	}{
+		{"Command: docker compose up", LineMeta},
+		{"=== OUTPUT ===", LineMeta},
+		{"", LineNormal},
+		{"STDOUT: #5 CACHED", LineNoise},
+		{"STDOUT: app-1  | > Task :test", LineTask},
+		{"STDOUT: app-1  | BUILD SUCCESSFUL in 3s", LineSuccess},
+		{"STDOUT: app-1  | BUILD FAILED", LineError},
+		{"STDERR: app-1  | some warning on stderr", LineStderr},
+		{"STDERR: app-1  | BUILD SUCCESSFUL", LineStderr},
+		{"STDOUT: app-1  | plain output line", LineNormal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			if kind := viewer.ClassifyLine(tt.line); kind != tt.expected {
+				t.Errorf("Expected kind %d for %q, got %d", tt.expected, tt.line, kind)
+			}
+		})
+	}
+}
+
+func TestComponent_Load_RendersColoredLines(t *testing.T) {
+	// Arrange
+	viewer := New(120, 30)
+	path := writeSampleLog(t)
+
+	// Act
+	err := viewer.Load(path)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	view := viewer.View()
+	if !strings.Contains(view, filepath.Base(path)) {
+		t.Error("Expected view to show the log file name")
+	}
+	for _, line := range []string{"> Task :test", "some warning on stderr", "plain output line"} {
+		if !strings.Contains(view, line) {
+			t.Errorf("Expected view to contain %q", line)
+		}
+	}
+	stderrLine := "STDERR: app-1  | some warning on stderr"
+	if viewer.renderLine(stderrLine) != stderrStyle.Render(stderrLine) {
+		t.Error("Expected stderr lines to be rendered with the stderr style")
+	}
+	taskLine := "STDOUT: app-1  | > Task :test"
+	if viewer.renderLine(taskLine) != taskStyle.Render(taskLine) {
+		t.Error("Expected task lines to be rendered with the task style")
+	}
+}
+
+func TestComponent_Load_MissingFile(t *testing.T) {
+	viewer := New(80, 20)
+
+	err := viewer.Load(filepath.Join(t.TempDir(), "missing.log"))
+
+	if err == nil {
+		t.Error("Expected error for missing log file")
+	}
+}
+
+func TestComponent_Update_BackSendsCloseMsg(t *testing.T) {
+	viewer := New(80, 20)
+
+	_, cmd := viewer.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if cmd == nil {
+		t.Fatal("Expected a command for esc")
+	}
+	if _, ok := cmd().(CloseMsg); !ok {
+		t.Error("Expected CloseMsg from esc")
+	}
+}
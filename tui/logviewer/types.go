@@ -0,0 +1,17 @@
+package logviewer
+
+// CloseMsg is sent when the user leaves the log viewer
+type CloseMsg struct{}
+
+// LineKind classifies a log line for coloring
+type LineKind int
+
+const (
+	LineNormal  LineKind = iota // Regular output
+	LineMeta                    // Run metadata written by the runner (command, headers)
+	LineNoise                   // Docker build noise
+	LineTask                    // Build/test task lines
+	LineSuccess                 // Success messages
+	LineError                   // Errors and warnings on stdout
+	LineStderr                  // Anything written to stderr
+)
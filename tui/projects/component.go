@@ -5,6 +5,8 @@ import (
 	"404skill-cli/config"
 	"404skill-cli/filesystem"
 	"404skill-cli/tui/components/table"
+	"404skill-cli/tui/domain"
+	"404skill-cli/tui/styles"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -48,10 +50,13 @@ func New(client api.ClientInterface, configManager *config.ConfigManager, fileMa
 
 // GetProjectStatus implements table.ProjectStatusProvider interface
 func (c *Component) GetProjectStatus(projectID string) string {
-	if c.configManager.IsProjectDownloaded(projectID) {
-		return "✓ Downloaded"
-	}
-	return ""
+	downloaded := c.configManager.IsProjectDownloaded(projectID)
+	return domain.ProjectStatus(downloaded, len(c.configManager.GetCompletedTasks(projectID)))
+}
+
+// GetProjectProgress implements table.ProjectProgressProvider using the number of completed tasks
+func (c *Component) GetProjectProgress(projectID string) int {
+	return len(c.configManager.GetCompletedTasks(projectID))
 }
 
 // SetLoading sets the loading state
@@ -99,6 +104,13 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 					return ProjectSelectedMsg{Project: selectedProject}
 				}
 			}
+		case "s":
+			c.table.CycleSort()
+			return c, nil
+		case "D":
+			styles.ToggleDifficultyColors()
+			c.table.UpdateProjectStatus()
+			return c, nil
 		}
 	case []api.Project:
 		c.SetProjects(msg)
@@ -118,15 +130,14 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 func (c *Component) handleDownloadedProject(project *api.Project) tea.Cmd {
 	return func() tea.Msg {
 		// Try to open the project directory
-		homeDir, err := os.UserHomeDir()
+		projectsDir, err := c.configManager.GetProjectsDir()
 		if err != nil {
-			return ProjectsErrorMsg{Error: "Project already downloaded but couldn't determine home directory."}
+			return ProjectsErrorMsg{Error: "Project already downloaded but couldn't determine the projects directory."}
 		}
 
 		// Format project name for directory
 		repoName := strings.ToLower(strings.ReplaceAll(project.Name, " ", "_"))
 		projectDirName := fmt.Sprintf("%s_%s", repoName, project.ID)
-		projectsDir := filepath.Join(homeDir, "404skill_projects")
 
 		// Try to find the project directory
 		entries, err := os.ReadDir(projectsDir)
@@ -48,11 +48,13 @@ func (m *MockClient) InitializeProject(ctx context.Context, projectID string) er
 	return nil
 }
 
-func (m *MockClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) error {
+func (m *MockClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*api.BulkUpdateResponse, error) {
 	if m.bulkUpdateProfileFunc != nil {
-		return m.bulkUpdateProfileFunc(ctx, failed, passed, projectID)
+		if err := m.bulkUpdateProfileFunc(ctx, failed, passed, projectID); err != nil {
+			return nil, err
+		}
 	}
-	return nil
+	return &api.BulkUpdateResponse{}, nil
 }
 
 // setupIsolatedConfig creates a unique config file for testing with an initial config
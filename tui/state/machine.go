@@ -33,6 +33,8 @@ const (
 
 	// TestProject - Legacy test project functionality screen (to be removed)
 	TestProject
+	// FailingTests - Tests failing in the latest run of every downloaded project
+	FailingTests
 )
 
 // String returns a human-readable representation of the state
@@ -54,6 +56,8 @@ func (s State) String() string {
 		return "TestProjectVariantMenu"
 	case TestProject:
 		return "TestProject"
+	case FailingTests:
+		return "FailingTests"
 	default:
 		return fmt.Sprintf("Unknown(%d)", int(s))
 	}
@@ -61,7 +65,7 @@ func (s State) String() string {
 
 // IsValid checks if the state is a valid state
 func (s State) IsValid() bool {
-	return s >= RefreshingToken && s <= TestProject
+	return s >= RefreshingToken && s <= FailingTests
 }
 
 // Transition represents a state transition
@@ -0,0 +1,47 @@
+package styles
+
+import (
+	"os"
+	"strings"
+
+	"404skill-cli/tui/theme"
+
+	"github.com/charmbracelet/lipgloss"
+	btable "github.com/evertras/bubble-table/table"
+)
+
+// difficultyColors maps lower-cased difficulty levels to a colour readable on dark and light terminals
+var difficultyColors = map[string]lipgloss.AdaptiveColor{
+	"easy":   {Light: string(theme.LightTheme.Success), Dark: string(theme.DarkTheme.Success)},
+	"medium": {Light: string(theme.LightTheme.Warning), Dark: string(theme.DarkTheme.Warning)},
+	"hard":   {Light: string(theme.LightTheme.Error), Dark: string(theme.DarkTheme.Error)},
+}
+
+// plainDifficulty turns difficulty colouring off in every table, toggled with [D]
+var plainDifficulty bool
+
+// ToggleDifficultyColors switches difficulty colouring on or off and reports whether it is now on
+func ToggleDifficultyColors() bool {
+	plainDifficulty = !plainDifficulty
+	return !plainDifficulty
+}
+
+// DifficultyColorsEnabled reports whether difficulty cells are coloured
+func DifficultyColorsEnabled() bool {
+	return !plainDifficulty && !noColor()
+}
+
+// DifficultyCell renders a difficulty for a table: green, yellow or red by level, or plain text
+// when colouring is off, NO_COLOR is set or the level is unknown
+func DifficultyCell(difficulty string) interface{} {
+	color, ok := difficultyColors[strings.ToLower(strings.TrimSpace(difficulty))]
+	if !ok || !DifficultyColorsEnabled() {
+		return difficulty
+	}
+	return btable.NewStyledCell(difficulty, lipgloss.NewStyle().Foreground(color))
+}
+
+// noColor reports whether the user asked for no colour output (https://no-color.org)
+func noColor() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
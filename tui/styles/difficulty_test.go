@@ -0,0 +1,73 @@
+package styles
+
+import (
+	"testing"
+
+	"404skill-cli/tui/theme"
+
+	"github.com/charmbracelet/lipgloss"
+	btable "github.com/evertras/bubble-table/table"
+)
+
+func TestDifficultyCell_ColorsEachLevel(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	tests := []struct {
+		difficulty string
+		wantColor  lipgloss.AdaptiveColor
+	}{
+		{"easy", lipgloss.AdaptiveColor{Light: string(theme.LightTheme.Success), Dark: string(theme.DarkTheme.Success)}},
+		{"Medium", lipgloss.AdaptiveColor{Light: string(theme.LightTheme.Warning), Dark: string(theme.DarkTheme.Warning)}},
+		{"HARD", lipgloss.AdaptiveColor{Light: string(theme.LightTheme.Error), Dark: string(theme.DarkTheme.Error)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.difficulty, func(t *testing.T) {
+			// Act
+			cell := DifficultyCell(tt.difficulty)
+
+			// Assert
+			styled, ok := cell.(btable.StyledCell)
+			if !ok {
+				t.Fatalf("Expected a styled cell, got %T", cell)
+			}
+			if styled.Data != tt.difficulty {
+				t.Errorf("Expected cell text %q, got %v", tt.difficulty, styled.Data)
+			}
+			if got := styled.Style.GetForeground(); got != tt.wantColor {
+				t.Errorf("Expected foreground %v, got %v", tt.wantColor, got)
+			}
+		})
+	}
+}
+
+func TestDifficultyCell_PlainWhenColoursOff(t *testing.T) {
+	tests := []struct {
+		name    string
+		noColor string
+		toggled bool
+		level   string
+	}{
+		{name: "toggled off", toggled: true, level: "easy"},
+		{name: "NO_COLOR set", noColor: "1", level: "hard"},
+		{name: "unknown level", level: "expert"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			t.Setenv("NO_COLOR", tt.noColor)
+			if tt.toggled {
+				ToggleDifficultyColors()
+				t.Cleanup(func() { ToggleDifficultyColors() })
+			}
+
+			// Act
+			cell := DifficultyCell(tt.level)
+
+			// Assert
+			if cell != tt.level {
+				t.Errorf("Expected plain text %q, got %#v", tt.level, cell)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package styles
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	btable "github.com/evertras/bubble-table/table"
+)
+
+// LanguageBadge is the short label and colour shown for a project's language
+type LanguageBadge struct {
+	Label string
+	Color lipgloss.Color
+}
+
+// unknownLanguageColor is used for languages without a badge of their own
+var unknownLanguageColor = lipgloss.Color("#888888")
+
+// languageBadges maps lower-cased language and technology names to their badge
+var languageBadges = map[string]LanguageBadge{
+	"go":         {Label: "Go", Color: lipgloss.Color("#00add8")},
+	"golang":     {Label: "Go", Color: lipgloss.Color("#00add8")},
+	"python":     {Label: "Py", Color: lipgloss.Color("#ffd43b")},
+	"py":         {Label: "Py", Color: lipgloss.Color("#ffd43b")},
+	"javascript": {Label: "JS", Color: lipgloss.Color("#f7df1e")},
+	"js":         {Label: "JS", Color: lipgloss.Color("#f7df1e")},
+	"node":       {Label: "JS", Color: lipgloss.Color("#f7df1e")},
+	"nodejs":     {Label: "JS", Color: lipgloss.Color("#f7df1e")},
+	"typescript": {Label: "TS", Color: lipgloss.Color("#3178c6")},
+	"ts":         {Label: "TS", Color: lipgloss.Color("#3178c6")},
+	"java":       {Label: "Java", Color: lipgloss.Color("#f89820")},
+	"kotlin":     {Label: "Kt", Color: lipgloss.Color("#a97bff")},
+	"c#":         {Label: "C#", Color: lipgloss.Color("#9b4f96")},
+	"csharp":     {Label: "C#", Color: lipgloss.Color("#9b4f96")},
+	"dotnet":     {Label: "C#", Color: lipgloss.Color("#9b4f96")},
+	"rust":       {Label: "Rs", Color: lipgloss.Color("#dea584")},
+	"ruby":       {Label: "Rb", Color: lipgloss.Color("#cc342d")},
+}
+
+// LanguageBadgeFor returns the badge for a language. Unknown languages get their
+// capitalised name, cut to four characters, in a neutral grey.
+func LanguageBadgeFor(language string) LanguageBadge {
+	key := strings.ToLower(strings.TrimSpace(language))
+	if badge, ok := languageBadges[key]; ok {
+		return badge
+	}
+	if key == "" {
+		return LanguageBadge{Label: "?", Color: unknownLanguageColor}
+	}
+
+	label := []rune(strings.TrimSpace(language))
+	if len(label) > 4 {
+		label = label[:4]
+	}
+	return LanguageBadge{Label: strings.ToUpper(string(label[:1])) + string(label[1:]), Color: unknownLanguageColor}
+}
+
+// LanguageCell renders a language badge as a coloured table cell, with a dot marker when
+// the terminal can show it and a plain ASCII marker otherwise
+func LanguageCell(language string) btable.StyledCell {
+	badge := LanguageBadgeFor(language)
+	marker := "*"
+	if unicodeSupported() {
+		marker = "●"
+	}
+	style := lipgloss.NewStyle()
+	if !noColor() {
+		style = style.Foreground(badge.Color)
+	}
+	return btable.NewStyledCell(marker+" "+badge.Label, style)
+}
+
+// unicodeSupported guesses from the locale whether the terminal renders non-ASCII symbols
+func unicodeSupported() bool {
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			value = strings.ToLower(value)
+			return strings.Contains(value, "utf-8") || strings.Contains(value, "utf8")
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package styles
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestLanguageBadgeFor(t *testing.T) {
+	tests := []struct {
+		language  string
+		wantLabel string
+		wantColor lipgloss.Color
+	}{
+		{"Go", "Go", lipgloss.Color("#00add8")},
+		{"golang", "Go", lipgloss.Color("#00add8")},
+		{" python ", "Py", lipgloss.Color("#ffd43b")},
+		{"JavaScript", "JS", lipgloss.Color("#f7df1e")},
+		{"typescript", "TS", lipgloss.Color("#3178c6")},
+		{"java", "Java", lipgloss.Color("#f89820")},
+		{"C#", "C#", lipgloss.Color("#9b4f96")},
+		{"elixir", "Elix", unknownLanguageColor},
+		{"", "?", unknownLanguageColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.language, func(t *testing.T) {
+			// Act
+			badge := LanguageBadgeFor(tt.language)
+
+			// Assert
+			if badge.Label != tt.wantLabel || badge.Color != tt.wantColor {
+				t.Errorf("Expected %s in %s, got %s in %s", tt.wantLabel, tt.wantColor, badge.Label, badge.Color)
+			}
+		})
+	}
+}
+
+func TestLanguageCell_ASCIIFallback(t *testing.T) {
+	// Arrange
+	if runtime.GOOS == "windows" {
+		t.Skip("Windows terminals are assumed to render Unicode")
+	}
+	t.Setenv("LC_ALL", "C")
+
+	// Act
+	cell := LanguageCell("python")
+
+	// Assert
+	if text, _ := cell.Data.(string); text != "* Py" || strings.ContainsAny(text, "●") {
+		t.Errorf("Expected an ASCII marker without a UTF-8 locale, got %q", cell.Data)
+	}
+}
+
+func TestLanguageCell_Unicode(t *testing.T) {
+	// Arrange
+	t.Setenv("LC_ALL", "en_US.UTF-8")
+
+	// Act
+	cell := LanguageCell("go")
+
+	// Assert
+	if cell.Data != "● Go" {
+		t.Errorf("Expected a dot marker with a UTF-8 locale, got %q", cell.Data)
+	}
+}
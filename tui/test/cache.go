@@ -0,0 +1,100 @@
+package test
+
+import (
+	"fmt"
+
+	"404skill-cli/testreport"
+	"404skill-cli/testrunner"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// CachedRunMsg carries the outcome of checking a project's sources before a run. Run is the saved
+// run when the sources haven't changed since it, nil when the tests need to run.
+type CachedRunMsg struct {
+	Project testrunner.Project
+	Run     *testreport.StoredRun
+}
+
+// ShowCachedRunMsg opens the saved run of a project whose sources haven't changed since it
+type ShowCachedRunMsg struct {
+	Project testrunner.Project
+	Run     *testreport.StoredRun
+}
+
+// cachedRunOffer is a saved run offered instead of testing an unchanged project again
+type cachedRunOffer struct {
+	project testrunner.Project
+	run     *testreport.StoredRun
+}
+
+// sourceHasher returns the runner's source hasher when unchanged runs may be reused, nil otherwise
+func (c *TestComponent) sourceHasher() testrunner.SourceHasher {
+	if c.configManager.GetResultsDir() == "" || !c.configManager.IsCacheUnchangedRunsEnabled() {
+		return nil
+	}
+	hasher, _ := c.testRunner.(testrunner.SourceHasher)
+	return hasher
+}
+
+// requestRun starts testing a project. When reusing unchanged runs is enabled, the sources are
+// hashed first, so the saved results can be offered if nothing changed since they were recorded.
+func (c *TestComponent) requestRun(project testrunner.Project) tea.Cmd {
+	hasher := c.sourceHasher()
+	if hasher == nil {
+		return c.startRun(project)
+	}
+	resultsDir := c.configManager.GetResultsDir()
+	c.statusMsg = "Checking for source changes..."
+	return func() tea.Msg {
+		return CachedRunMsg{Project: project, Run: testrunner.UnchangedRun(hasher, resultsDir, project)}
+	}
+}
+
+// handleCachedRun offers the saved run of an unchanged project, or starts the run otherwise
+func (c *TestComponent) handleCachedRun(msg CachedRunMsg) tea.Cmd {
+	c.statusMsg = ""
+	if msg.Run == nil {
+		return c.startRun(msg.Project)
+	}
+	c.cachedOffer = &cachedRunOffer{project: msg.Project, run: msg.Run}
+	return nil
+}
+
+// updateCachedOffer answers the offer of a saved run; every other key is ignored until it is answered
+func (c *TestComponent) updateCachedOffer(msg tea.KeyMsg) tea.Cmd {
+	offer := c.cachedOffer
+	switch msg.String() {
+	case "enter", "y":
+		c.cachedOffer = nil
+		return c.showCachedRun(offer.project, offer.run)
+	case "r":
+		c.cachedOffer = nil
+		return c.startRun(offer.project)
+	case "n":
+		c.cachedOffer = nil
+	}
+	return nil
+}
+
+// showCachedRun shows a saved run in place of testing the unchanged project again
+func (c *TestComponent) showCachedRun(project testrunner.Project, run *testreport.StoredRun) tea.Cmd {
+	c.testing = false
+	c.errorMsg = ""
+	c.lastError = ""
+	c.statusMsg = ""
+	c.completedMsg = ""
+	c.currentProject = &project
+	c.showingTestResults = true
+	c.buildTestResultsView(run.Result)
+	c.cacheMsg = fmt.Sprintf("Saved results from %s, the sources haven't changed since", run.RanAt.Format("Jan 2 15:04"))
+	return c.watchReports(&project)
+}
+
+// cachedOfferView asks whether to show the saved run instead of testing again
+func (c *TestComponent) cachedOfferView() string {
+	question := fmt.Sprintf("%s hasn't changed since its last run on %s.",
+		c.cachedOffer.project.Name, c.cachedOffer.run.RanAt.Format("Jan 2 15:04"))
+	return headerStyle.Render(question) + "\n" +
+		helpStyle.Render("[enter] show the saved results • [r] run the tests anyway • [n] cancel")
+}
@@ -2,14 +2,21 @@ package test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"404skill-cli/api"
+	"404skill-cli/config"
+	"404skill-cli/support"
 	"404skill-cli/testreport"
 	"404skill-cli/testrunner"
 	"404skill-cli/tracing"
+	"404skill-cli/tui/domain"
+	"404skill-cli/tui/history"
+	"404skill-cli/tui/logviewer"
+	"404skill-cli/tui/styles"
 	"404skill-cli/tui/testresults"
 
 	"github.com/charmbracelet/bubbles/help"
@@ -25,6 +32,7 @@ var (
 	successStyle = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("2"))
 	helpStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
 	spinnerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("6"))
+	badgeStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3"))
 
 	// Spinner frames for animation
 	spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
@@ -36,6 +44,11 @@ type TestComponent struct {
 	testRunner    testrunner.TestRunner
 	configManager ConfigManager
 	apiClient     APIClient
+	clipboard     Clipboard
+	urlOpener     URLOpener
+	version       string
+
+	reportPollInterval time.Duration // how often open results check for a newer report, default 2s
 
 	// UI State
 	table                btable.Model
@@ -43,19 +56,48 @@ type TestComponent struct {
 	spinnerFrame         string
 	showingTestResults   bool
 	testResultsComponent *testresults.TestResultsComponent
+	logViewer            *logviewer.Component
+	history              *history.Component
+	width                int
+	height               int
 
 	// Data
+	apiProjects        []api.Project // downloaded projects in the order they were set
+	sortMode           domain.CompletionSort
 	projects           []testrunner.Project
 	currentProject     *testrunner.Project
+	currentResult      *testreport.ParseResult
 	testResultsSummary string
 	testResultsList    []string
 
 	// State
 	testing      bool
 	errorMsg     string
+	statusMsg    string
+	completedMsg string // celebrates tasks completed by the latest run
+	rerunMsg     string // progress or outcome of re-running a single test
+	usageMsg     string // duration and peak resource use of the latest run, when sampled
+	rerunning    bool
+	resultsURL   string // permalink to the latest results, empty when the backend returned none
+	shareMsg     string // outcome of copying the results permalink
+	lastError    string // full text of the most recent error, for copying to support
+	rawReport    string // report of the last run that could not be parsed, empty otherwise
+	watching     bool   // the open results reload when a newer report appears
+	watchID      int    // identifies the current watch, so polls of a superseded one stop
+	watchMsg     string // outcome of the latest reload
+	cacheMsg     string // notes that the results shown are a saved run
 	outputBuffer []string
+
+	ownReportTime time.Time       // write time of the report of the last single-test rerun, never reloaded
+	cachedOffer   *cachedRunOffer // saved run offered instead of testing an unchanged project, nil when none is
 }
 
+// Fallback log viewer size until the terminal size is known
+const (
+	defaultViewerWidth  = 100
+	defaultViewerHeight = 24
+)
+
 // New creates a new TestComponent with dependency injection
 func New(testRunner testrunner.TestRunner, configManager ConfigManager, apiClient APIClient) *TestComponent {
 	columns := []btable.Column{
@@ -78,6 +120,28 @@ func New(testRunner testrunner.TestRunner, configManager ConfigManager, apiClien
 	}
 }
 
+// SetSupportInfo provides the clipboard and CLI version used when copying errors for support
+func (c *TestComponent) SetSupportInfo(version string, clipboard Clipboard) {
+	c.version = version
+	c.clipboard = clipboard
+}
+
+// SetURLOpener provides the browser launcher used to open a project's web page
+func (c *TestComponent) SetURLOpener(opener URLOpener) {
+	c.urlOpener = opener
+}
+
+// SetSize records the terminal size for the results and the log viewer, including ones opened later
+func (c *TestComponent) SetSize(width, height int) {
+	c.width, c.height = width, height
+	if c.logViewer != nil {
+		c.logViewer.SetSize(width, height)
+	}
+	if c.testResultsComponent != nil {
+		c.testResultsComponent.SetHeight(height)
+	}
+}
+
 // Init initializes the component
 func (c *TestComponent) Init() tea.Cmd {
 	return nil
@@ -85,30 +149,54 @@ func (c *TestComponent) Init() tea.Cmd {
 
 // SetProjects updates the list of projects and rebuilds the table
 func (c *TestComponent) SetProjects(projects []api.Project) {
+	c.apiProjects = nil
+	for _, p := range projects {
+		if c.configManager.IsProjectDownloaded(p.ID) {
+			c.apiProjects = append(c.apiProjects, p)
+		}
+	}
+	c.refreshTable()
+}
+
+// refreshTable rebuilds the table rows in the current sort order, keeping the highlighted project selected
+func (c *TestComponent) refreshTable() {
+	var highlightedID string
+	if selected := c.table.HighlightedRow(); selected.Data != nil {
+		highlightedID, _ = selected.Data["id"].(string)
+	}
+
+	progress := func(projectID string) int {
+		return len(c.configManager.GetCompletedTasks(projectID))
+	}
+	sorted := domain.SortByCompletion(c.apiProjects, progress, c.sortMode)
+
 	c.projects = nil
 	rows := []btable.Row{}
+	highlightedIndex := 0
 
-	for _, p := range projects {
-		if c.configManager.IsProjectDownloaded(p.ID) {
-			project := testrunner.Project{
-				ID:       p.ID,
-				Name:     p.Name,
-				Language: p.Language,
-			}
-			c.projects = append(c.projects, project)
+	for i, p := range sorted {
+		if p.ID == highlightedID {
+			highlightedIndex = i
+		}
 
-			rows = append(rows, btable.NewRow(map[string]interface{}{
-				"id":     p.ID,
-				"name":   p.Name,
-				"lang":   p.Language,
-				"diff":   p.Difficulty,
-				"dur":    fmt.Sprintf("%d min", p.EstimatedDurationInMinutes),
-				"status": "✓ Downloaded",
-			}))
+		project := testrunner.Project{
+			ID:       p.ID,
+			Name:     p.Name,
+			Language: p.Language,
 		}
+		c.projects = append(c.projects, project)
+
+		rows = append(rows, btable.NewRow(map[string]interface{}{
+			"id":     p.ID,
+			"name":   p.Name,
+			"lang":   styles.LanguageCell(p.Language),
+			"diff":   styles.DifficultyCell(p.Difficulty),
+			"dur":    fmt.Sprintf("%d min", p.EstimatedDurationInMinutes),
+			"status": domain.ProjectStatus(true, progress(p.ID)),
+		}))
 	}
 
-	c.table = c.table.WithRows(rows)
+	c.table = c.table.WithRows(rows).WithHighlightedRow(highlightedIndex)
 }
 
 // Update handles incoming messages
@@ -116,8 +204,52 @@ func (c *TestComponent) Update(msg tea.Msg) (Component, tea.Cmd) {
 	var cmd tea.Cmd
 
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		c.SetSize(msg.Width, msg.Height)
+
+	case logviewer.CloseMsg:
+		c.logViewer = nil
+		return c, nil
+
+	case history.CloseMsg:
+		c.history = nil
+		return c, nil
+
+	case history.TimeFormatToggledMsg:
+		if err := c.configManager.SetHistoryRelativeTime(msg.Relative); err != nil {
+			_ = tracing.TrackError(fmt.Errorf("failed to save history time format: %w", err), "test_component")
+		}
+		return c, nil
+
 	case tea.KeyMsg:
+		if c.logViewer != nil {
+			c.logViewer, cmd = c.logViewer.Update(msg)
+			return c, cmd
+		}
+		if c.history != nil {
+			c.history, cmd = c.history.Update(msg)
+			return c, cmd
+		}
+		if c.cachedOffer != nil {
+			return c, c.updateCachedOffer(msg)
+		}
+
+		if msg.String() == "c" && c.lastError != "" {
+			c.copyErrorReport()
+			return c, nil
+		}
+
 		if c.showingTestResults {
+			// Sharing is only offered when the backend returned a permalink
+			if msg.String() == "S" && c.resultsURL != "" {
+				c.copyResultsURL()
+				return c, nil
+			}
+			if msg.String() == "w" {
+				c.openProjectPage()
+				return c, nil
+			}
+
 			// Handle dismissing test results
 			switch msg.String() {
 			case "esc", "b":
@@ -131,19 +263,6 @@ func (c *TestComponent) Update(msg tea.Msg) (Component, tea.Cmd) {
 				if c.testResultsComponent != nil {
 					updatedComponent, cmd := c.testResultsComponent.Update(msg)
 					c.testResultsComponent = updatedComponent.(*testresults.TestResultsComponent)
-
-					// Check for back message
-					if cmd != nil {
-						if backMsg := cmd(); backMsg != nil {
-							if _, ok := backMsg.(testresults.BackToTestListMsg); ok {
-								c.showingTestResults = false
-								c.testResultsComponent = nil
-								c.testResultsSummary = ""
-								c.testResultsList = nil
-								return c, nil
-							}
-						}
-					}
 					return c, cmd
 				}
 				return c, nil
@@ -155,28 +274,38 @@ func (c *TestComponent) Update(msg tea.Msg) (Component, tea.Cmd) {
 			return c, nil
 		}
 
+		// A run whose report could not be parsed can be inspected or retried
+		if c.rawReport != "" {
+			switch msg.String() {
+			case "v":
+				c.openRawReport()
+				return c, nil
+			case "r":
+				if c.currentProject != nil {
+					return c, c.startRun(*c.currentProject)
+				}
+			}
+		}
+
 		switch msg.String() {
+		case "s":
+			c.sortMode = c.sortMode.Next()
+			c.refreshTable()
+			return c, nil
+		case "h":
+			c.openHistory()
+			return c, nil
+		case "D":
+			styles.ToggleDifficultyColors()
+			c.refreshTable()
+			return c, nil
 		case "enter":
 			selected := c.table.HighlightedRow()
 			if selected.Data != nil {
 				if id, ok := selected.Data["id"].(string); ok {
 					for _, p := range c.projects {
 						if p.ID == id {
-							// Clear ALL previous test state
-							c.showingTestResults = false
-							c.testResultsComponent = nil
-							c.testResultsSummary = ""
-							c.testResultsList = nil
-							c.errorMsg = ""
-							c.outputBuffer = nil
-							c.currentProject = nil
-
-							c.testing = true
-							c.currentProject = &p
-							return c, tea.Batch(
-								c.runTestsCmd(p),
-								c.spinnerTick(),
-							)
+							return c, c.requestRun(p)
 						}
 					}
 				}
@@ -188,20 +317,117 @@ func (c *TestComponent) Update(msg tea.Msg) (Component, tea.Cmd) {
 			}
 		}
 
+	case testresults.BackToTestListMsg:
+		c.showingTestResults = false
+		c.testResultsComponent = nil
+		c.testResultsSummary = ""
+		c.testResultsList = nil
+		return c, nil
+
+	case testresults.HeaderToggledMsg:
+		if err := c.configManager.SetCompactResultsHeader(msg.Compact); err != nil {
+			_ = tracing.TrackError(fmt.Errorf("failed to save results header preference: %w", err), "test_component")
+		}
+		return c, nil
+
+	case testresults.ViewLogMsg:
+		c.openLogViewer()
+		return c, nil
+
+	case testresults.RerunTestMsg:
+		return c, c.rerunTest(msg.Test)
+
+	case testresults.CopyFailureMsg:
+		c.copyFailure(msg.Test)
+		return c, nil
+
+	case testresults.CopyReportMsg:
+		c.copyReport(msg.Text)
+		return c, nil
+
+	case SingleTestCompleteMsg:
+		c.rerunning = false
+		if msg.Error != "" {
+			c.rerunMsg = ""
+			c.lastError = msg.Error
+			c.statusMsg = "Re-run failed: " + msg.Error + " • [c] copy error"
+			return c, nil
+		}
+		c.lastError = ""
+		// The rerun wrote a report of its own, with only this test, which must not replace the results
+		if watcher, ok := c.testRunner.(testrunner.ReportWatcher); ok && c.currentProject != nil {
+			c.ownReportTime, _ = watcher.LatestReportTime(*c.currentProject)
+		}
+		if c.currentResult != nil && c.currentResult.MergeResult(*msg.Result) {
+			if c.testResultsComponent != nil {
+				c.testResultsComponent.SetResults(c.currentResult)
+			}
+			c.saveLastRun(c.currentResult, c.currentProject, "") // the sources may have changed since the full run
+		}
+		if msg.Result.Passed {
+			c.rerunMsg = fmt.Sprintf("✓ %s now passes", msg.Name)
+		} else {
+			c.rerunMsg = fmt.Sprintf("✗ %s still fails", msg.Name)
+		}
+		return c, nil
+
 	case TestCompleteMsg:
 		c.testing = false
+		c.statusMsg = ""
+		c.completedMsg = ""
+		if msg.Project != nil {
+			c.currentProject = msg.Project
+		}
+		c.rawReport = msg.ReportPath
 		if msg.Error != "" {
 			_ = tracing.TrackError(fmt.Errorf("test completed with error: %s", msg.Error), "test_component")
 			c.errorMsg = msg.Error
+			c.lastError = msg.Error
 			return c, nil
 		}
 
-		// Show test results
+		// A successful run leaves no error for [c] to copy
+		c.errorMsg = ""
+		c.lastError = ""
+
+		// Show test results, compared with the run saved before this one
+		previous := c.loadPreviousRun(msg.Project)
 		c.showingTestResults = true
 		c.buildTestResultsView(msg.Result)
+		if msg.Usage != nil {
+			c.usageMsg = msg.Usage.Summary()
+			c.testResultsSummary += "\n" + c.usageMsg
+		}
+		c.testResultsComponent.SetPreviousResults(previous)
+		c.recordCompletedTasks(msg.Result, msg.Project)
+		c.recordRun(msg.Result, msg.Project)
+		c.saveLastRun(msg.Result, msg.Project, msg.SourceHash)
 
 		// Update API - use project from message instead of component state
-		return c, c.updateAPICmd(msg.Result, msg.Project)
+		return c, tea.Batch(c.updateAPICmd(msg.Result, msg.Project), c.watchReports(msg.Project))
+
+	case ShowStoredResultsMsg:
+		c.testing = false
+		c.errorMsg = ""
+		c.lastError = ""
+		c.statusMsg = ""
+		c.completedMsg = ""
+		c.currentProject = msg.Project
+		c.showingTestResults = true
+		c.buildTestResultsView(msg.Result)
+		return c, c.watchReports(msg.Project)
+
+	case CachedRunMsg:
+		return c, c.handleCachedRun(msg)
+
+	case ShowCachedRunMsg:
+		return c, c.showCachedRun(msg.Project, msg.Run)
+
+	case reportPollMsg:
+		return c, c.handleReportPoll(msg)
+
+	case ReportReloadedMsg:
+		return c, c.handleReportReloaded(msg)
 
 	case TestProgressMsg:
 		if msg.Line != "" {
@@ -212,6 +438,7 @@ func (c *TestComponent) Update(msg tea.Msg) (Component, tea.Cmd) {
 	case TestErrorMsg:
 		c.testing = false
 		c.errorMsg = msg.Error
+		c.lastError = msg.Error
 		return c, nil
 
 	case spinnerMsg:
@@ -224,22 +451,79 @@ func (c *TestComponent) Update(msg tea.Msg) (Component, tea.Cmd) {
 	case apiUpdateCompleteMsg:
 		if msg.err != nil {
 			c.testResultsSummary += "\n\n[API update failed: " + msg.err.Error() + "]"
+			c.lastError = msg.err.Error()
+			c.statusMsg = "API update failed: " + msg.err.Error() + " • [c] copy error"
 		} else {
 			c.testResultsSummary += "\n\n[API update successful!]"
+			c.resultsURL = msg.resultsURL
 		}
 		return c, nil
 	}
 
+	// While results are open, the rest belongs to them, e.g. the timeout of an ambiguous task jump
+	if c.showingTestResults && c.testResultsComponent != nil {
+		updatedComponent, cmd := c.testResultsComponent.Update(msg)
+		c.testResultsComponent = updatedComponent.(*testresults.TestResultsComponent)
+		return c, cmd
+	}
+
 	c.table, cmd = c.table.Update(msg)
 	return c, cmd
 }
 
 // View renders the component
 func (c *TestComponent) View() string {
+	if c.logViewer != nil {
+		return c.logViewer.View()
+	}
+
+	if c.history != nil {
+		return c.history.View()
+	}
+
 	if c.showingTestResults {
 		if c.testResultsComponent != nil {
 			// Use the enhanced test results component
-			return c.testResultsComponent.View()
+			view := c.testResultsComponent.View()
+			if c.testResultsComponent.IsPrintView() {
+				// Nothing but the report, so it copies and screenshots cleanly
+				if c.shareMsg != "" {
+					view += "\n" + helpStyle.Render(c.shareMsg)
+				}
+				return view
+			}
+			if c.completedMsg != "" {
+				view = badgeStyle.Render(c.completedMsg) + "\n\n" + view
+			}
+			if c.usageMsg != "" {
+				view += "\n" + helpStyle.Render(c.usageMsg)
+			}
+			if c.cacheMsg != "" {
+				view += "\n" + helpStyle.Render(c.cacheMsg)
+			}
+			if c.watching {
+				live := "Live: watching for new test reports"
+				if c.watchMsg != "" {
+					live = c.watchMsg
+				}
+				view += "\n" + helpStyle.Render(live)
+			}
+			if c.rerunMsg != "" {
+				view += "\n" + helpStyle.Render(c.rerunMsg)
+			}
+			if c.resultsURL != "" {
+				view += "\n" + helpStyle.Render("[S] copy results link")
+			}
+			if c.currentProjectPage() != "" {
+				view += "\n" + helpStyle.Render("[w] open project page")
+			}
+			if c.shareMsg != "" {
+				view += "\n" + helpStyle.Render(c.shareMsg)
+			}
+			if c.statusMsg != "" {
+				view += "\n" + errorStyle.Render(c.statusMsg)
+			}
+			return view
 		}
 		// Fallback to original view if component not available
 		var b strings.Builder
@@ -270,12 +554,22 @@ func (c *TestComponent) View() string {
 		Quit:  "q",
 	}
 
-	helpView := helpStyle.Render(fmt.Sprintf("[%s] select • [%s] back • [%s] quit",
-		keyMap.Enter, keyMap.Back, keyMap.Quit))
+	helpView := helpStyle.Render(fmt.Sprintf("[%s] select • [s] sort: %s • [h] history • [D] colours • [%s] back • [%s] quit",
+		keyMap.Enter, c.sortMode, keyMap.Back, keyMap.Quit))
 	view := fmt.Sprintf("%s\n%s", c.table.View(), helpView)
 
 	if c.errorMsg != "" {
-		view = fmt.Sprintf("%s\n\n%s", view, errorStyle.Render(c.errorMsg))
+		actions := "[c] copy error"
+		if c.rawReport != "" {
+			actions = "[v] view raw report • [r] retry run • " + actions
+		}
+		view = fmt.Sprintf("%s\n\n%s\n%s", view, errorStyle.Render(c.errorMsg), helpStyle.Render(actions))
+	}
+	if c.statusMsg != "" {
+		view = fmt.Sprintf("%s\n%s", view, helpStyle.Render(c.statusMsg))
+	}
+	if c.cachedOffer != nil {
+		view = fmt.Sprintf("%s\n\n%s", view, c.cachedOfferView())
 	}
 
 	return view
@@ -284,42 +578,353 @@ func (c *TestComponent) View() string {
 // buildTestResultsView constructs the test results display
 func (c *TestComponent) buildTestResultsView(result *testreport.ParseResult) {
 	// Create and configure the enhanced test results component
+	c.currentResult = result
+	c.rerunMsg = ""
+	c.usageMsg = ""
+	c.cacheMsg = ""
+	c.resultsURL = ""
+	c.shareMsg = ""
 	c.testResultsComponent = testresults.New()
+	c.testResultsComponent.SetExcludedPatterns(c.configManager.GetExcludedTestPatterns())
+	c.testResultsComponent.SetCompactHeader(c.configManager.IsCompactResultsHeader())
+	if c.height > 0 {
+		c.testResultsComponent.SetHeight(c.height)
+	}
 	c.testResultsComponent.SetResults(result)
 
 	// Keep the original summary for API update messages
-	testCount := result.Suite.Tests
-	passedCount := len(result.PassedTests)
-	failedCount := len(result.FailedTests)
-	testTime := result.Suite.Time
+	c.testResultsSummary = resultsSummary(result)
+}
 
-	c.testResultsSummary = fmt.Sprintf(
+// resultsSummary is the header shown above the results of a run
+func resultsSummary(result *testreport.ParseResult) string {
+	return fmt.Sprintf(
 		"%s\n\nTotal: %d   Passed: %d   Failed: %d   Time: %.2fs",
 		headerStyle.Render("Test Results: "+result.Suite.Name),
-		testCount, passedCount, failedCount, testTime,
+		result.Suite.Tests, len(result.PassedTests), len(result.FailedTests), result.Suite.Time,
 	)
 }
 
+// recordCompletedTasks persists tasks that now meet the completion threshold and celebrates new ones
+func (c *TestComponent) recordCompletedTasks(result *testreport.ParseResult, project *testrunner.Project) {
+	if result == nil || project == nil {
+		return
+	}
+
+	completed := result.GroupedResults.CompletedTasks(c.configManager.GetCompletionThreshold())
+	if len(completed) == 0 {
+		return
+	}
+
+	newlyCompleted, err := c.configManager.RecordCompletedTasks(project.ID, completed)
+	if err != nil {
+		_ = tracing.TrackError(fmt.Errorf("failed to record completed tasks: %w", err), "test_component")
+		return
+	}
+	c.completedMsg = CompletionMessage(newlyCompleted)
+}
+
+// recordRun appends the run to the project's history
+func (c *TestComponent) recordRun(result *testreport.ParseResult, project *testrunner.Project) {
+	if result == nil || project == nil {
+		return
+	}
+
+	run := config.RunRecord{
+		Time:     time.Now(),
+		Passed:   len(result.PassedTests),
+		Failed:   len(result.FailedTests),
+		Duration: result.Suite.Time,
+	}
+	if err := c.configManager.RecordRun(project.ID, run); err != nil {
+		_ = tracing.TrackError(fmt.Errorf("failed to record run history: %w", err), "test_component")
+	}
+}
+
+// saveLastRun keeps the project's latest results on disk for the failing tests overview, with the
+// hash of the sources they tested so an unchanged project can reuse them
+func (c *TestComponent) saveLastRun(result *testreport.ParseResult, project *testrunner.Project, sourceHash string) {
+	if result == nil || project == nil || c.configManager.GetResultsDir() == "" {
+		return
+	}
+
+	run := testreport.StoredRun{
+		ProjectID:   project.ID,
+		ProjectName: project.Name,
+		Language:    project.Language,
+		RanAt:       time.Now(),
+		Result:      result,
+		SourceHash:  sourceHash,
+	}
+	if err := testreport.SaveLastRun(c.configManager.GetResultsDir(), run); err != nil {
+		_ = tracing.TrackError(fmt.Errorf("failed to save latest results: %w", err), "test_component")
+	}
+}
+
+// loadPreviousRun reads the project's last saved results, so the new run can be compared with them
+func (c *TestComponent) loadPreviousRun(project *testrunner.Project) *testreport.ParseResult {
+	if project == nil || c.configManager.GetResultsDir() == "" {
+		return nil
+	}
+	run, err := testreport.LoadLastRun(c.configManager.GetResultsDir(), project.ID)
+	if err != nil {
+		_ = tracing.TrackError(fmt.Errorf("failed to load previous results: %w", err), "test_component")
+		return nil
+	}
+	if run == nil {
+		return nil
+	}
+	return run.Result
+}
+
+// openHistory shows the run history of the highlighted project
+func (c *TestComponent) openHistory() {
+	selected := c.table.HighlightedRow()
+	if selected.Data == nil {
+		return
+	}
+	id, _ := selected.Data["id"].(string)
+	name := id
+	for _, p := range c.projects {
+		if p.ID == id {
+			name = p.Name
+			break
+		}
+	}
+
+	c.history = history.New(name, c.configManager.GetRunHistory(id), c.configManager.IsHistoryRelativeTime())
+}
+
+// CompletionMessage celebrates newly completed tasks, or returns an empty string if there are none
+func CompletionMessage(tasks []int) string {
+	if len(tasks) == 0 {
+		return ""
+	}
+	parts := make([]string, len(tasks))
+	for i, task := range tasks {
+		parts[i] = fmt.Sprintf("Task %d complete!", task)
+	}
+	return "🎉 " + strings.Join(parts, " ")
+}
+
+// copyErrorReport copies the last error with version and session details for a support ticket
+func (c *TestComponent) copyErrorReport() {
+	if c.clipboard == nil {
+		c.statusMsg = "Clipboard is not available"
+		return
+	}
+
+	report := support.FormatErrorReport(c.lastError, c.version, support.SessionID(), time.Now())
+	if err := c.clipboard.CopyToClipboard(report); err != nil {
+		c.statusMsg = fmt.Sprintf("Could not copy to clipboard: %v", err)
+		return
+	}
+	c.statusMsg = "Error details copied to clipboard"
+}
+
+// copyResultsURL copies the shareable results permalink to the clipboard
+func (c *TestComponent) copyResultsURL() {
+	if c.clipboard == nil {
+		c.shareMsg = "Clipboard is not available"
+		return
+	}
+	if err := c.clipboard.CopyToClipboard(c.resultsURL); err != nil {
+		c.shareMsg = fmt.Sprintf("Could not copy to clipboard: %v", err)
+		return
+	}
+	c.shareMsg = "Results link copied: " + c.resultsURL
+}
+
+// copyFailure copies a test's failure report, with its name, class and details, to the clipboard
+func (c *TestComponent) copyFailure(test testreport.TestResult) {
+	if c.clipboard == nil {
+		c.shareMsg = "Clipboard is not available"
+		return
+	}
+	if err := c.clipboard.CopyToClipboard(testresults.FormatFailureReport(test)); err != nil {
+		c.shareMsg = fmt.Sprintf("Could not copy to clipboard: %v", err)
+		return
+	}
+	c.shareMsg = "Copied " + test.Name + " to clipboard"
+}
+
+// openProjectPage opens the current project's web page with its instructions in the browser
+func (c *TestComponent) openProjectPage() {
+	page := c.currentProjectPage()
+	switch {
+	case page == "":
+		c.shareMsg = "This project has no web page"
+	case c.urlOpener == nil:
+		c.shareMsg = "Project page: " + page
+	default:
+		if err := c.urlOpener.OpenURL(page); err != nil {
+			c.shareMsg = fmt.Sprintf("Could not open browser: %v", err)
+			return
+		}
+		c.shareMsg = "Opening " + page + " in your browser..."
+	}
+}
+
+// currentProjectPage returns the web page of the project whose results are shown, or ""
+func (c *TestComponent) currentProjectPage() string {
+	if c.currentProject == nil {
+		return ""
+	}
+	for _, p := range c.apiProjects {
+		if p.ID == c.currentProject.ID {
+			return p.WebPage()
+		}
+	}
+	return ""
+}
+
+// copyReport copies the full plain text results from the print view to the clipboard
+func (c *TestComponent) copyReport(text string) {
+	if c.clipboard == nil {
+		c.shareMsg = "Clipboard is not available"
+		return
+	}
+	if err := c.clipboard.CopyToClipboard(text); err != nil {
+		c.shareMsg = fmt.Sprintf("Could not copy to clipboard: %v", err)
+		return
+	}
+	c.shareMsg = "Copied the full results to clipboard"
+}
+
+// ResultsURL returns the permalink to the latest results, or an empty string if there is none
+func (c *TestComponent) ResultsURL() string {
+	return c.resultsURL
+}
+
+// openLogViewer loads the latest run log of the current project into the inline viewer
+func (c *TestComponent) openLogViewer() {
+	c.statusMsg = ""
+
+	locator, ok := c.testRunner.(testrunner.LogLocator)
+	if !ok || c.currentProject == nil {
+		c.statusMsg = "No test log available for this run."
+		return
+	}
+
+	path, err := locator.LatestLogPath(*c.currentProject)
+	if err != nil {
+		c.statusMsg = fmt.Sprintf("Could not find test log: %v", err)
+		return
+	}
+
+	width, height := c.width, c.height
+	if width == 0 || height == 0 {
+		width, height = defaultViewerWidth, defaultViewerHeight
+	}
+
+	viewer := logviewer.New(width, height)
+	if err := viewer.Load(path); err != nil {
+		c.statusMsg = err.Error()
+		return
+	}
+	c.logViewer = viewer
+}
+
+// startRun clears the previous run's state and starts testing a project
+func (c *TestComponent) startRun(project testrunner.Project) tea.Cmd {
+	c.showingTestResults = false
+	c.testResultsComponent = nil
+	c.testResultsSummary = ""
+	c.testResultsList = nil
+	c.errorMsg = ""
+	c.lastError = ""
+	c.statusMsg = ""
+	c.rawReport = ""
+	c.outputBuffer = nil
+
+	c.testing = true
+	c.currentProject = &project
+	return tea.Batch(
+		c.runTestsCmd(project),
+		c.spinnerTick(),
+	)
+}
+
+// openRawReport shows the report that could not be parsed in the inline viewer
+func (c *TestComponent) openRawReport() {
+	c.statusMsg = ""
+	width, height := c.width, c.height
+	if width == 0 || height == 0 {
+		width, height = defaultViewerWidth, defaultViewerHeight
+	}
+
+	viewer := logviewer.New(width, height)
+	if err := viewer.Load(c.rawReport); err != nil {
+		c.statusMsg = err.Error()
+		return
+	}
+	c.logViewer = viewer
+}
+
 // runTestsCmd creates a command to run tests for a project
 func (c *TestComponent) runTestsCmd(project testrunner.Project) tea.Cmd {
+	hasher := c.sourceHasher()
 	return func() tea.Msg {
 		progressCallback := func(line string) {
 			// Progress callback - could be enhanced to send real-time updates
 			// For now, the enhanced error messages will contain full output
 		}
 
+		// Hash before running, so edits made during the run count as changes next time
+		var sourceHash string
+		if hasher != nil {
+			sourceHash, _ = hasher.SourceHash(project)
+		}
+
 		result, err := c.testRunner.RunTests(project, progressCallback)
 		if err != nil {
-			return TestCompleteMsg{
+			msg := TestCompleteMsg{
 				Project: &project,
 				Error:   err.Error(),
 			}
+			var parseErr *testrunner.ReportParseError
+			if errors.As(err, &parseErr) {
+				msg.ReportPath = parseErr.Path
+			}
+			return msg
+		}
+
+		msg := TestCompleteMsg{
+			Project:    &project,
+			Result:     result,
+			SourceHash: sourceHash,
 		}
+		if reporter, ok := c.testRunner.(testrunner.UsageReporter); ok {
+			if usage, ok := reporter.LastUsage(project); ok {
+				msg.Usage = &usage
+			}
+		}
+		return msg
+	}
+}
+
+// rerunTest starts re-running a single test, if the runner supports it
+func (c *TestComponent) rerunTest(test testreport.TestResult) tea.Cmd {
+	if c.rerunning {
+		return nil
+	}
+	runner, ok := c.testRunner.(testrunner.SingleTestRunner)
+	if !ok || c.currentProject == nil {
+		c.rerunMsg = "Re-running a single test is not available for this project"
+		return nil
+	}
 
-		return TestCompleteMsg{
-			Project: &project,
-			Result:  result,
+	c.rerunning = true
+	c.statusMsg = ""
+	c.rerunMsg = fmt.Sprintf("Re-running %s...", test.Name)
+	project := *c.currentProject
+
+	return func() tea.Msg {
+		result, err := runner.RunTest(project, test, nil)
+		if err != nil {
+			return SingleTestCompleteMsg{Name: test.Name, Error: err.Error()}
 		}
+		return SingleTestCompleteMsg{Name: test.Name, Result: result}
 	}
 }
 
@@ -333,25 +938,33 @@ func (c *TestComponent) updateAPICmd(result *testreport.ParseResult, project *te
 			return apiUpdateCompleteMsg{err: fmt.Errorf("no current project")}
 		}
 
+		// Scaffold tests are still shown in the results but never reported as progress
+		failed, passed := result.CountedTests(c.configManager.GetExcludedTestPatterns())
+
 		tracker.AddMetadata("project_id", project.ID)
-		tracker.AddMetadata("passed_count", fmt.Sprintf("%d", len(result.PassedTests)))
-		tracker.AddMetadata("failed_count", fmt.Sprintf("%d", len(result.FailedTests)))
+		tracker.AddMetadata("passed_count", fmt.Sprintf("%d", len(passed)))
+		tracker.AddMetadata("failed_count", fmt.Sprintf("%d", len(failed)))
+		tracker.AddMetadata("excluded_count", fmt.Sprintf("%d", len(result.PassedTests)+len(result.FailedTests)-len(passed)-len(failed)))
 
 		ctx := context.Background()
-		err := c.apiClient.BulkUpdateProfileTests(
+		resp, err := c.apiClient.BulkUpdateProfileTests(
 			ctx,
-			result.FailedTests,
-			result.PassedTests,
+			failed,
+			passed,
 			project.ID,
 		)
 
 		if err != nil {
 			_ = tracker.CompleteWithError(err)
-		} else {
-			_ = tracker.Complete()
+			return apiUpdateCompleteMsg{err: err}
 		}
+		_ = tracker.Complete()
 
-		return apiUpdateCompleteMsg{err: err}
+		var resultsURL string
+		if resp != nil {
+			resultsURL = resp.ResultsURL
+		}
+		return apiUpdateCompleteMsg{resultsURL: resultsURL}
 	}
 }
 
@@ -372,7 +985,15 @@ func (c *TestComponent) spinnerTick() tea.Cmd {
 }
 
 // API update completion message
-type apiUpdateCompleteMsg struct{ err error }
+type apiUpdateCompleteMsg struct {
+	err        error
+	resultsURL string // shareable permalink, if the backend returned one
+}
+
+// IsViewingLog returns whether the inline log viewer is open
+func (c *TestComponent) IsViewingLog() bool {
+	return c.logViewer != nil
+}
 
 // IsShowingTestResults returns whether test results are currently being displayed
 func (c *TestComponent) IsShowingTestResults() bool {
@@ -4,13 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"404skill-cli/api"
+	"404skill-cli/config"
 	"404skill-cli/testreport"
 	"404skill-cli/testrunner"
+	"404skill-cli/tui/logviewer"
+	"404skill-cli/tui/testresults"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -28,7 +33,17 @@ func (m *MockTestRunner) RunTests(project testrunner.Project, progressCallback f
 }
 
 type MockConfigManager struct {
-	isProjectDownloadedFunc func(projectID string) bool
+	isProjectDownloadedFunc  func(projectID string) bool
+	getCompletedTasksFunc    func(projectID string) []int
+	recordCompletedTasksFunc func(projectID string, tasks []int) ([]int, error)
+	completionThreshold      float64
+	excludedPatterns         []string
+	runHistory               map[string][]config.RunRecord
+	historyRelativeTime      bool
+	compactResultsHeader     bool
+	resultsDir               string
+	watchReports             bool
+	cacheUnchangedRuns       bool
 }
 
 func (m *MockConfigManager) IsProjectDownloaded(projectID string) bool {
@@ -38,14 +53,97 @@ func (m *MockConfigManager) IsProjectDownloaded(projectID string) bool {
 	return false
 }
 
+func (m *MockConfigManager) GetCompletionThreshold() float64 {
+	if m.completionThreshold > 0 {
+		return m.completionThreshold
+	}
+	return 1.0
+}
+
+func (m *MockConfigManager) GetCompletedTasks(projectID string) []int {
+	if m.getCompletedTasksFunc != nil {
+		return m.getCompletedTasksFunc(projectID)
+	}
+	return nil
+}
+
+func (m *MockConfigManager) RecordCompletedTasks(projectID string, tasks []int) ([]int, error) {
+	if m.recordCompletedTasksFunc != nil {
+		return m.recordCompletedTasksFunc(projectID, tasks)
+	}
+	return tasks, nil
+}
+
+func (m *MockConfigManager) GetExcludedTestPatterns() []string {
+	return m.excludedPatterns
+}
+
+func (m *MockConfigManager) RecordRun(projectID string, run config.RunRecord) error {
+	if m.runHistory == nil {
+		m.runHistory = make(map[string][]config.RunRecord)
+	}
+	m.runHistory[projectID] = append(m.runHistory[projectID], run)
+	return nil
+}
+
+func (m *MockConfigManager) GetRunHistory(projectID string) []config.RunRecord {
+	return m.runHistory[projectID]
+}
+
+func (m *MockConfigManager) IsHistoryRelativeTime() bool {
+	return m.historyRelativeTime
+}
+
+func (m *MockConfigManager) SetHistoryRelativeTime(relative bool) error {
+	m.historyRelativeTime = relative
+	return nil
+}
+
+func (m *MockConfigManager) IsCompactResultsHeader() bool {
+	return m.compactResultsHeader
+}
+
+func (m *MockConfigManager) SetCompactResultsHeader(compact bool) error {
+	m.compactResultsHeader = compact
+	return nil
+}
+
+func (m *MockConfigManager) GetResultsDir() string {
+	return m.resultsDir
+}
+
+func (m *MockConfigManager) IsWatchReportsEnabled() bool {
+	return m.watchReports
+}
+
+func (m *MockConfigManager) IsCacheUnchangedRunsEnabled() bool {
+	return m.cacheUnchangedRuns
+}
+
 type MockAPIClient struct {
 	bulkUpdateProfileTestsFunc func(ctx context.Context, failed []string, passed []string, projectID string) error
+	resultsURL                 string
 }
 
-func (m *MockAPIClient) BulkUpdateProfileTests(ctx context.Context, failed []string, passed []string, projectID string) error {
+func (m *MockAPIClient) BulkUpdateProfileTests(ctx context.Context, failed []string, passed []string, projectID string) (*api.BulkUpdateResponse, error) {
 	if m.bulkUpdateProfileTestsFunc != nil {
-		return m.bulkUpdateProfileTestsFunc(ctx, failed, passed, projectID)
+		if err := m.bulkUpdateProfileTestsFunc(ctx, failed, passed, projectID); err != nil {
+			return nil, err
+		}
 	}
+	return &api.BulkUpdateResponse{ResultsURL: m.resultsURL}, nil
+}
+
+type MockClipboard struct {
+	copied string
+	err    error
+}
+
+func (m *MockClipboard) CopyToClipboard(text string) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.copied = text
 	return nil
 }
 
@@ -343,6 +441,223 @@ func TestTestComponent_Update_APIUpdateMessages(t *testing.T) {
 	}
 }
 
+func TestTestComponent_CopyErrorReport(t *testing.T) {
+	// Arrange
+	clipboard := &MockClipboard{}
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	component.SetSupportInfo("1.2.3", clipboard)
+	updatedComponent, _ := component.Update(apiUpdateCompleteMsg{err: errors.New("API request failed with status 502")})
+	component = updatedComponent.(*TestComponent)
+
+	// Act
+	updatedComponent, _ = component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	component = updatedComponent.(*TestComponent)
+
+	// Assert
+	for _, want := range []string{"API request failed with status 502", "Version:    1.2.3", "Session ID: "} {
+		if !strings.Contains(clipboard.copied, want) {
+			t.Errorf("Expected copied text to contain %q, got:\n%s", want, clipboard.copied)
+		}
+	}
+	if component.statusMsg != "Error details copied to clipboard" {
+		t.Errorf("Unexpected status message: %q", component.statusMsg)
+	}
+}
+
+func TestTestComponent_CopyErrorReport_ClearedBySuccessfulRun(t *testing.T) {
+	// Arrange
+	clipboard := &MockClipboard{}
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	component.SetSupportInfo("1.2.3", clipboard)
+	component.Update(TestErrorMsg{Error: "docker daemon not running"})
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+
+	// Act
+	component.Update(TestCompleteMsg{Project: project, Result: result})
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+
+	// Assert
+	if clipboard.copied != "" {
+		t.Errorf("Expected no stale error to be copied after a successful run, got:\n%s", clipboard.copied)
+	}
+}
+
+func TestTestComponent_TaskJumpTimeoutReachesResults(t *testing.T) {
+	// Arrange
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, GroupedResults: &testreport.GroupedTestResults{}}
+	for _, n := range []int{1, 2, 12} {
+		test := testreport.TestResult{Name: fmt.Sprintf("test_task%d", n), ClassName: fmt.Sprintf("test_api.TestTask%d", n), Passed: true}
+		result.Suite.Results = append(result.Suite.Results, test)
+		result.PassedTests = append(result.PassedTests, test.Name)
+		result.GroupedResults.Classes = append(result.GroupedResults.Classes, testreport.TestClass{
+			Name: fmt.Sprintf("Task%d", n), DisplayName: fmt.Sprintf("Task %d", n), TaskNumber: n,
+			Tests: []testreport.TestResult{test}, PassedCount: 1,
+		})
+	}
+	component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p1", Name: "Journal API"}, Result: result})
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+
+	// Act
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	if cmd == nil {
+		t.Fatal("Expected \"1\" to wait for a possible second digit")
+	}
+	component.Update(cmd())
+
+	// Assert
+	if selected := component.testResultsComponent.GetSelectedTest(); selected == nil || selected.Name != "test_task1" {
+		t.Errorf("Expected Task 1 to be selected once the jump timed out, got %v", selected)
+	}
+}
+
+func TestTestComponent_CopyResultsPermalink(t *testing.T) {
+	// Arrange
+	clipboard := &MockClipboard{}
+	apiClient := &MockAPIClient{resultsURL: "https://404skill.dev/r/abc123"}
+	component := New(&MockTestRunner{}, &MockConfigManager{}, apiClient)
+	component.SetSupportInfo("1.2.3", clipboard)
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+
+	// Act
+	_, cmd := component.Update(TestCompleteMsg{Project: project, Result: result})
+	component.Update(cmd())
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+
+	// Assert
+	if component.ResultsURL() != "https://404skill.dev/r/abc123" {
+		t.Errorf("Expected the returned URL to be captured, got %q", component.ResultsURL())
+	}
+	if clipboard.copied != "https://404skill.dev/r/abc123" {
+		t.Errorf("Expected the permalink to be copied, got %q", clipboard.copied)
+	}
+	if !strings.Contains(component.View(), "Results link copied") {
+		t.Errorf("Expected copy confirmation in view, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_ShowsResourceUsage(t *testing.T) {
+	// Arrange
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+	usage := &testrunner.ResourceUsage{Duration: 134 * time.Second, PeakMemory: 1_200_000_000, Sampled: true}
+
+	// Act
+	component.Update(TestCompleteMsg{Project: project, Result: result, Usage: usage})
+
+	// Assert
+	if !strings.Contains(component.View(), "Tests ran in 2m14s, peak 1.2GB RAM") {
+		t.Errorf("Expected the resource usage in the view, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_CopyResultsPermalink_DisabledWithoutURL(t *testing.T) {
+	// Arrange
+	clipboard := &MockClipboard{}
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	component.SetSupportInfo("1.2.3", clipboard)
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+
+	// Act
+	_, cmd := component.Update(TestCompleteMsg{Project: project, Result: result})
+	component.Update(cmd())
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+
+	// Assert
+	if clipboard.copied != "" {
+		t.Errorf("Expected nothing to be copied without a permalink, got %q", clipboard.copied)
+	}
+	if strings.Contains(component.View(), "copy results link") {
+		t.Error("Expected the share action to be hidden without a permalink")
+	}
+}
+
+type MockURLOpener struct {
+	opened string
+}
+
+func (m *MockURLOpener) OpenURL(url string) error {
+	m.opened = url
+	return nil
+}
+
+func TestTestComponent_OpenProjectPage(t *testing.T) {
+	// Arrange
+	opener := &MockURLOpener{}
+	configManager := &MockConfigManager{isProjectDownloadedFunc: func(string) bool { return true }}
+	component := New(&MockTestRunner{}, configManager, &MockAPIClient{})
+	component.SetURLOpener(opener)
+	component.SetProjects([]api.Project{
+		{ID: "p1", Name: "Journal API", PageURL: "https://404skill.dev/projects/journal-api"},
+		{ID: "p2", Name: "Todo CLI"},
+	})
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+
+	// Act
+	_, cmd := component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p1", Name: "Journal API"}, Result: result})
+	component.Update(cmd())
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+
+	// Assert
+	if opener.opened != "https://404skill.dev/projects/journal-api" {
+		t.Errorf("Expected the project page to be opened, got %q", opener.opened)
+	}
+	if !strings.Contains(component.View(), "in your browser") {
+		t.Errorf("Expected an opening confirmation in view, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_OpenProjectPage_WithoutPage(t *testing.T) {
+	// Arrange
+	opener := &MockURLOpener{}
+	configManager := &MockConfigManager{isProjectDownloadedFunc: func(string) bool { return true }}
+	component := New(&MockTestRunner{}, configManager, &MockAPIClient{})
+	component.SetURLOpener(opener)
+	component.SetProjects([]api.Project{{ID: "p2", Name: "Todo CLI"}})
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+
+	// Act
+	_, cmd := component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p2", Name: "Todo CLI"}, Result: result})
+	component.Update(cmd())
+	viewBefore := component.View()
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+
+	// Assert
+	if opener.opened != "" {
+		t.Errorf("Expected nothing to be opened, got %q", opener.opened)
+	}
+	if strings.Contains(viewBefore, "open project page") {
+		t.Error("Expected the page action to be hidden without a page")
+	}
+	if !strings.Contains(component.View(), "no web page") {
+		t.Errorf("Expected a missing page notice, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_CopyErrorReport_ClipboardFailure(t *testing.T) {
+	clipboard := &MockClipboard{err: errors.New("no clipboard utility")}
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	component.SetSupportInfo("1.2.3", clipboard)
+	component.Update(TestErrorMsg{Error: "docker not running"})
+
+	updatedComponent, _ := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	component = updatedComponent.(*TestComponent)
+
+	if !strings.Contains(component.statusMsg, "no clipboard utility") {
+		t.Errorf("Expected clipboard error in status, got %q", component.statusMsg)
+	}
+}
+
 func TestTestComponent_View_States(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -680,6 +995,59 @@ func TestTestComponent_APICallAfterTestCompletion(t *testing.T) {
 	}
 }
 
+func TestTestComponent_ExcludedTestsNotReported(t *testing.T) {
+	// Arrange
+	var capturedFailed, capturedPassed []string
+	apiClient := &MockAPIClient{
+		bulkUpdateProfileTestsFunc: func(ctx context.Context, failed []string, passed []string, projectID string) error {
+			capturedFailed = failed
+			capturedPassed = passed
+			return nil
+		},
+	}
+	configManager := &MockConfigManager{excludedPatterns: []string{"ExampleTest.*", "test_scaffold_*"}}
+	component := New(&MockTestRunner{}, configManager, apiClient)
+	testProject := &testrunner.Project{ID: "test-project-123", Name: "Test Project"}
+	component.currentProject = testProject
+
+	testResult := &testreport.ParseResult{
+		Suite:       testreport.TestSuite{Name: "Suite", Tests: 4},
+		PassedTests: []string{"test_real_pass", "test_example", "test_scaffold_setup"},
+		FailedTests: []string{"test_real_fail"},
+	}
+	testResult.Suite.Results = []testreport.TestResult{
+		{Name: "test_real_pass", ClassName: "Task1Test", Passed: true},
+		{Name: "test_example", ClassName: "ExampleTest", Passed: true},
+		{Name: "test_scaffold_setup", ClassName: "Task1Test", Passed: true},
+		{Name: "test_real_fail", ClassName: "Task1Test", Passed: false, Failure: &testreport.TestFailure{Message: "boom"}},
+	}
+
+	// Act
+	updatedComponent, cmd := component.Update(TestCompleteMsg{Project: testProject, Result: testResult})
+	component = updatedComponent.(*TestComponent)
+	if cmd == nil {
+		t.Fatal("Expected API update command after test completion")
+	}
+	cmd()
+	view := component.testResultsComponent.View()
+
+	// Assert
+	if len(capturedPassed) != 1 || capturedPassed[0] != "test_real_pass" {
+		t.Errorf("Expected only test_real_pass to be reported as passed, got %v", capturedPassed)
+	}
+	if len(capturedFailed) != 1 || capturedFailed[0] != "test_real_fail" {
+		t.Errorf("Expected only test_real_fail to be reported as failed, got %v", capturedFailed)
+	}
+	for _, name := range []string{"test_example", "test_scaffold_setup", "test_real_pass"} {
+		if !strings.Contains(view, name) {
+			t.Errorf("Expected %s to still be displayed", name)
+		}
+	}
+	if strings.Count(view, "not counted") != 2 {
+		t.Errorf("Expected the two excluded tests to be marked not counted, got view:\n%s", view)
+	}
+}
+
 func TestTestComponent_APICallFailsWhenNoCurrentProject(t *testing.T) {
 	// This test verifies what happens when currentProject is nil during API update
 	var apiCallMade bool
@@ -712,9 +1080,9 @@ func TestTestComponent_APICallFailsWhenNoCurrentProject(t *testing.T) {
 		{Name: "test_passed_1", Passed: true, Time: 0.5},
 	}
 
+	// The API update uses the project from the message, so leave it out as well
 	completeMsg := TestCompleteMsg{
-		Project: &testrunner.Project{ID: "some-project", Name: "Some Project"},
-		Result:  testResult,
+		Result: testResult,
 	}
 
 	// Update component with test completion message
@@ -919,3 +1287,501 @@ func TestTestComponent_CompleteFlowWithAPICall(t *testing.T) {
 		t.Errorf("Expected API call count to remain 1, got %d", apiCallCount)
 	}
 }
+
+func TestTestComponent_TaskCompletion(t *testing.T) {
+	// Arrange
+	var recordedProject string
+	var recordedTasks []int
+	configManager := &MockConfigManager{
+		recordCompletedTasksFunc: func(projectID string, tasks []int) ([]int, error) {
+			recordedProject = projectID
+			recordedTasks = tasks
+			return []int{2}, nil // Task 1 was already complete
+		},
+	}
+	component := New(&MockTestRunner{}, configManager, &MockAPIClient{})
+	result := &testreport.ParseResult{
+		Suite: testreport.TestSuite{Name: "Suite"},
+		GroupedResults: &testreport.GroupedTestResults{
+			Classes: []testreport.TestClass{
+				{Name: "Task1", DisplayName: "Task 1", TaskNumber: 1, PassedCount: 2},
+				{Name: "Task2", DisplayName: "Task 2", TaskNumber: 2, PassedCount: 1},
+				{Name: "Task3", DisplayName: "Task 3", TaskNumber: 3, PassedCount: 1, FailedCount: 1},
+			},
+		},
+	}
+
+	// Act
+	updatedComponent, _ := component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p1"}, Result: result})
+	component = updatedComponent.(*TestComponent)
+
+	// Assert
+	if recordedProject != "p1" || fmt.Sprint(recordedTasks) != "[1 2]" {
+		t.Errorf("Expected tasks [1 2] recorded for p1, got %v for %q", recordedTasks, recordedProject)
+	}
+	view := component.View()
+	if !strings.Contains(view, "Task 2 complete!") {
+		t.Errorf("Expected celebration for newly completed task, got:\n%s", view)
+	}
+	if strings.Contains(view, "Task 1 complete!") || strings.Contains(view, "Task 3 complete!") {
+		t.Errorf("Expected only newly completed tasks to be celebrated, got:\n%s", view)
+	}
+}
+
+func TestTestComponent_SetProjects_CompletionBadge(t *testing.T) {
+	// Arrange
+	configManager := &MockConfigManager{
+		isProjectDownloadedFunc: func(projectID string) bool { return true },
+		getCompletedTasksFunc:   func(projectID string) []int { return []int{1, 2} },
+	}
+	component := New(&MockTestRunner{}, configManager, &MockAPIClient{})
+
+	// Act
+	component.SetProjects([]api.Project{{ID: "p1", Name: "Journal API"}})
+
+	// Assert
+	if !strings.Contains(component.View(), "★2") {
+		t.Errorf("Expected completion badge in project table, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_RunHistory(t *testing.T) {
+	// Arrange
+	configManager := &MockConfigManager{isProjectDownloadedFunc: func(projectID string) bool { return true }}
+	component := New(&MockTestRunner{}, configManager, &MockAPIClient{})
+	component.SetProjects([]api.Project{{ID: "p1", Name: "Journal API"}})
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{
+		Suite:       testreport.TestSuite{Name: "Suite", Time: 2.5},
+		PassedTests: []string{"test_a", "test_b"},
+		FailedTests: []string{"test_c"},
+	}
+
+	// Act
+	component.Update(TestCompleteMsg{Project: project, Result: result})
+	component.Update(testresults.BackToTestListMsg{})
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	view := component.View()
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	component.Update(cmd())
+
+	// Assert
+	runs := configManager.GetRunHistory("p1")
+	if len(runs) != 1 || runs[0].Passed != 2 || runs[0].Failed != 1 {
+		t.Fatalf("Expected the run to be recorded, got %+v", runs)
+	}
+	if !strings.Contains(view, "Run History: Journal API") || !strings.Contains(view, "2 passed") {
+		t.Errorf("Expected the history view for the highlighted project, got:\n%s", view)
+	}
+	if !configManager.historyRelativeTime {
+		t.Error("Expected toggling the time format to be persisted")
+	}
+	if !strings.Contains(component.View(), "just now") {
+		t.Errorf("Expected relative times after toggling, got:\n%s", component.View())
+	}
+}
+
+// MockSingleTestRunner is a test runner that can also re-run a single test
+type MockSingleTestRunner struct {
+	MockTestRunner
+	runTestFunc func(project testrunner.Project, test testreport.TestResult, progressCallback func(string)) (*testreport.TestResult, error)
+}
+
+func (m *MockSingleTestRunner) RunTest(project testrunner.Project, test testreport.TestResult, progressCallback func(string)) (*testreport.TestResult, error) {
+	return m.runTestFunc(project, test, progressCallback)
+}
+
+func TestTestComponent_RerunSingleTest(t *testing.T) {
+	// Arrange
+	var rerunProject, rerunTest string
+	runner := &MockSingleTestRunner{
+		runTestFunc: func(project testrunner.Project, test testreport.TestResult, progressCallback func(string)) (*testreport.TestResult, error) {
+			rerunProject, rerunTest = project.ID, test.Name
+			return &testreport.TestResult{Name: test.Name, ClassName: test.ClassName, Passed: true}, nil
+		},
+	}
+	component := New(runner, &MockConfigManager{}, &MockAPIClient{})
+	failing := testreport.TestResult{Name: "test_create", ClassName: "test_api.TestTask1", Failure: &testreport.TestFailure{Message: "boom"}}
+	result := &testreport.ParseResult{
+		Suite:       testreport.TestSuite{Name: "Suite", Tests: 1, Failures: 1, Results: []testreport.TestResult{failing}},
+		FailedTests: []string{"test_create"},
+	}
+	component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p1"}, Result: result})
+
+	// Act
+	_, cmd := component.Update(testresults.RerunTestMsg{Test: failing})
+	if cmd == nil {
+		t.Fatal("Expected a command to re-run the test")
+	}
+	if !strings.Contains(component.View(), "Re-running test_create") {
+		t.Errorf("Expected re-run progress in view, got:\n%s", component.View())
+	}
+	component.Update(cmd())
+
+	// Assert
+	if rerunProject != "p1" || rerunTest != "test_create" {
+		t.Errorf("Expected test_create re-run for p1, got %q for %q", rerunTest, rerunProject)
+	}
+	if len(result.FailedTests) != 0 || len(result.PassedTests) != 1 {
+		t.Errorf("Expected merged result to pass, got passed=%v failed=%v", result.PassedTests, result.FailedTests)
+	}
+	view := component.View()
+	if !strings.Contains(view, "test_create now passes") || !strings.Contains(view, "[PASS]") {
+		t.Errorf("Expected updated status in view, got:\n%s", view)
+	}
+}
+
+func TestTestComponent_RerunSingleTest_Unsupported(t *testing.T) {
+	// Arrange
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+	test := testreport.TestResult{Name: "test_create"}
+	component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p1"}, Result: &testreport.ParseResult{
+		Suite: testreport.TestSuite{Results: []testreport.TestResult{test}},
+	}})
+
+	// Act
+	_, cmd := component.Update(testresults.RerunTestMsg{Test: test})
+
+	// Assert
+	if cmd != nil {
+		t.Error("Expected no command when the runner cannot re-run single tests")
+	}
+	if !strings.Contains(component.View(), "not available") {
+		t.Errorf("Expected explanation in view, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_MalformedReport_OffersRawReportAndRetry(t *testing.T) {
+	// Arrange - the run writes a report that is cut short
+	reportPath := filepath.Join(t.TempDir(), "results.xml")
+	if err := os.WriteFile(reportPath, []byte(`<?xml version="1.0"?><testsuite name="S"><testca`), 0644); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	runs := 0
+	runner := &MockTestRunner{runTestsFunc: func(testrunner.Project, func(string)) (*testreport.ParseResult, error) {
+		runs++
+		return nil, &testrunner.ReportParseError{Path: reportPath, Format: testreport.FormatJUnit, Err: errors.New("unexpected EOF")}
+	}}
+	component := New(runner, &MockConfigManager{}, &MockAPIClient{})
+	project := testrunner.Project{ID: "p1", Name: "Journal API"}
+
+	// Act
+	msg := component.runTestsCmd(project)()
+	component.Update(msg)
+
+	// Assert - the parse failure is reported with its actions
+	complete, ok := msg.(TestCompleteMsg)
+	if !ok || complete.ReportPath != reportPath {
+		t.Fatalf("Expected the unparsed report path in the message, got %#v", msg)
+	}
+	view := component.View()
+	if !strings.Contains(view, "couldn't be parsed") || !strings.Contains(view, "view raw report") {
+		t.Errorf("Expected the parse failure state, got:\n%s", view)
+	}
+
+	// Act - open the raw report
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+
+	// Assert
+	if !component.IsViewingLog() {
+		t.Fatalf("Expected the raw report to open in the viewer, status: %q", component.statusMsg)
+	}
+	if !strings.Contains(component.View(), "<testca") {
+		t.Errorf("Expected the raw report content, got:\n%s", component.View())
+	}
+
+	// Act - close the viewer and retry the run
+	component.Update(logviewer.CloseMsg{})
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+
+	// Assert
+	if cmd == nil || !component.testing {
+		t.Error("Expected the run to be retried")
+	}
+}
+
+func TestTestComponent_MissingReport_OffersNoRawReport(t *testing.T) {
+	// Arrange
+	component := New(&MockTestRunner{}, &MockConfigManager{}, &MockAPIClient{})
+
+	// Act
+	component.Update(TestCompleteMsg{Project: &testrunner.Project{ID: "p1"}, Error: "tests may not have run properly - no recent test report found"})
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+
+	// Assert
+	if strings.Contains(component.View(), "view raw report") {
+		t.Error("Expected no raw report action without a report")
+	}
+	if cmd != nil || component.testing {
+		t.Error("Expected no retry from the missing report state")
+	}
+}
+
+// writeJUnitReport writes a one-suite JUnit report and backdates it to modTime
+func writeJUnitReport(t *testing.T, dir, suite string, failed bool, modTime time.Time) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+	testcase := `<testcase name="test_a" time="0.1"/>`
+	failures := 0
+	if failed {
+		testcase = `<testcase name="test_a" time="0.1"><failure message="boom"/></testcase>`
+		failures = 1
+	}
+	report := fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuite name="%s" tests="1" failures="%d" time="0.1" timestamp="2024-03-20T10:00:00">%s</testsuite>`, suite, failures, testcase)
+	path := filepath.Join(dir, "TEST-"+suite+".xml")
+	if err := os.WriteFile(path, []byte(report), 0644); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set report time: %v", err)
+	}
+}
+
+func TestTestComponent_WatchReports_ReloadsNewReport(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	runner := testrunner.NewDefaultTestRunnerWithConfig(testrunner.RunnerConfig{ProjectsDir: projectsDir})
+	component := New(runner, &MockConfigManager{watchReports: true}, &MockAPIClient{})
+	component.reportPollInterval = time.Millisecond
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	reportsDir := filepath.Join(projectsDir, ".tests", "journal_api_p1", "test-reports")
+	started := time.Now().Add(-time.Minute)
+	writeJUnitReport(t, reportsDir, "Before", true, started)
+	first, err := runner.LoadLatestReport(*project)
+	if err != nil {
+		t.Fatalf("Failed to load the first report: %v", err)
+	}
+	_, cmd := component.Update(ShowStoredResultsMsg{Project: project, Result: first})
+	if cmd == nil {
+		t.Fatal("Expected the reports directory to be watched")
+	}
+
+	// Act - the first poll sees nothing new, then tests run in another terminal
+	msg := cmd()
+	if _, ok := msg.(reportPollMsg); !ok {
+		t.Fatalf("Expected an unchanged directory to keep polling, got %T", msg)
+	}
+	_, cmd = component.Update(msg)
+	writeJUnitReport(t, reportsDir, "After", false, started.Add(30*time.Second))
+	msg = cmd()
+	component.Update(msg)
+
+	// Assert
+	reloaded, ok := msg.(ReportReloadedMsg)
+	if !ok {
+		t.Fatalf("Expected the new report to be loaded, got %T", msg)
+	}
+	if reloaded.Result.Suite.Name != "After" {
+		t.Errorf("Expected the newer report, got suite %q", reloaded.Result.Suite.Name)
+	}
+	if component.currentResult != reloaded.Result {
+		t.Error("Expected the displayed results to be replaced by the new report")
+	}
+	if !strings.Contains(component.View(), "Live: reloaded the report") {
+		t.Errorf("Expected the reload to be shown, got:\n%s", component.View())
+	}
+}
+
+// reportingRerunner re-runs a test the way the docker runner does: the run writes a report
+// holding only the selected test
+type reportingRerunner struct {
+	*testrunner.DefaultTestRunner
+	reportsDir string
+	written    time.Time
+	t          *testing.T
+}
+
+func (r *reportingRerunner) RunTest(project testrunner.Project, test testreport.TestResult, progressCallback func(string)) (*testreport.TestResult, error) {
+	writeJUnitReport(r.t, r.reportsDir, "Rerun", false, r.written)
+	return &testreport.TestResult{Name: test.Name, ClassName: test.ClassName, Passed: true}, nil
+}
+
+func TestTestComponent_WatchReports_KeepsFullResultsAfterRerun(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	reportsDir := filepath.Join(projectsDir, ".tests", "journal_api_p1", "test-reports")
+	started := time.Now().Add(-time.Minute)
+	runner := &reportingRerunner{
+		DefaultTestRunner: testrunner.NewDefaultTestRunnerWithConfig(testrunner.RunnerConfig{ProjectsDir: projectsDir}),
+		reportsDir:        reportsDir,
+		written:           started.Add(30 * time.Second),
+		t:                 t,
+	}
+	component := New(runner, &MockConfigManager{watchReports: true}, &MockAPIClient{})
+	component.reportPollInterval = time.Millisecond
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	writeJUnitReport(t, reportsDir, "Before", true, started)
+	failing := testreport.TestResult{Name: "test_a", ClassName: "Suite", Passed: false}
+	full := &testreport.ParseResult{
+		Suite: testreport.TestSuite{Name: "Suite", Tests: 2, Results: []testreport.TestResult{
+			failing,
+			{Name: "test_b", ClassName: "Suite", Passed: true},
+		}},
+		FailedTests: []string{"test_a"},
+		PassedTests: []string{"test_b"},
+	}
+	_, poll := component.Update(ShowStoredResultsMsg{Project: project, Result: full})
+
+	// Act - the rerun writes its one-test report while the watcher keeps polling
+	_, rerun := component.Update(testresults.RerunTestMsg{Test: failing})
+	done := rerun()
+	msg := poll()
+	_, poll = component.Update(msg)
+	component.Update(done)
+	if poll != nil {
+		msg = poll()
+		component.Update(msg)
+	}
+
+	// Assert
+	if got := len(component.currentResult.Suite.Results); got != 2 {
+		t.Fatalf("Expected the rerun's report to leave all 2 tests shown, got %d", got)
+	}
+	if test := component.currentResult.FindTest("Suite", "test_a"); test == nil || !test.Passed {
+		t.Errorf("Expected the rerun result to be merged, got %+v", test)
+	}
+	if strings.Contains(component.View(), "Live: reloaded the report") {
+		t.Errorf("Expected the rerun's own report not to be reloaded, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_WatchReports_StopsWhenResultsClose(t *testing.T) {
+	// Arrange
+	projectsDir := t.TempDir()
+	runner := testrunner.NewDefaultTestRunnerWithConfig(testrunner.RunnerConfig{ProjectsDir: projectsDir})
+	component := New(runner, &MockConfigManager{watchReports: true}, &MockAPIClient{})
+	component.reportPollInterval = time.Millisecond
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}}
+	_, cmd := component.Update(ShowStoredResultsMsg{Project: project, Result: result})
+
+	// Act
+	component.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	_, next := component.Update(cmd())
+
+	// Assert
+	if next != nil {
+		t.Error("Expected polling to stop once the results view is closed")
+	}
+}
+
+func TestTestComponent_WatchReports_DisabledByDefault(t *testing.T) {
+	// Arrange
+	runner := testrunner.NewDefaultTestRunnerWithConfig(testrunner.RunnerConfig{ProjectsDir: t.TempDir()})
+	component := New(runner, &MockConfigManager{}, &MockAPIClient{})
+	project := &testrunner.Project{ID: "p1", Name: "Journal API"}
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}}
+
+	// Act
+	_, cmd := component.Update(ShowStoredResultsMsg{Project: project, Result: result})
+
+	// Assert
+	if cmd != nil {
+		t.Error("Expected no watch unless watch_reports is enabled")
+	}
+	if strings.Contains(component.View(), "Live:") {
+		t.Errorf("Expected no live indicator, got:\n%s", component.View())
+	}
+}
+
+// MockSourceHashRunner is a runner that can fingerprint a project's sources
+type MockSourceHashRunner struct {
+	MockTestRunner
+	hash string
+}
+
+func (m *MockSourceHashRunner) SourceHash(project testrunner.Project) (string, error) {
+	return m.hash, nil
+}
+
+// newCachedRunComponent returns a component whose project p1 has a saved run of unchanged sources
+func newCachedRunComponent(t *testing.T, runs *int) *TestComponent {
+	t.Helper()
+	resultsDir := t.TempDir()
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Saved Suite"}, PassedTests: []string{"test_a"}}
+	result.Suite.Results = []testreport.TestResult{{Name: "test_a", Passed: true}}
+	run := testreport.StoredRun{ProjectID: "p1", ProjectName: "Journal API", RanAt: time.Now(), Result: result, SourceHash: "abc"}
+	if err := testreport.SaveLastRun(resultsDir, run); err != nil {
+		t.Fatalf("Failed to save run: %v", err)
+	}
+
+	runner := &MockSourceHashRunner{hash: "abc"}
+	runner.runTestsFunc = func(testrunner.Project, func(string)) (*testreport.ParseResult, error) {
+		*runs++
+		return &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Fresh Suite"}}, nil
+	}
+	configManager := &MockConfigManager{
+		isProjectDownloadedFunc: func(string) bool { return true },
+		resultsDir:              resultsDir,
+		cacheUnchangedRuns:      true,
+	}
+	component := New(runner, configManager, &MockAPIClient{})
+	component.SetProjects([]api.Project{{ID: "p1", Name: "Journal API", Language: "go"}})
+	return component
+}
+
+func TestTestComponent_CachedRun_OffersSavedResults(t *testing.T) {
+	// Arrange
+	runs := 0
+	component := newCachedRunComponent(t, &runs)
+
+	// Act
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	component.Update(cmd())
+	component.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if runs != 0 {
+		t.Errorf("Expected no run for unchanged sources, got %d", runs)
+	}
+	if !component.showingTestResults {
+		t.Fatal("Expected the saved results to be shown")
+	}
+	if !strings.Contains(component.View(), "the sources haven't changed since") {
+		t.Errorf("Expected the saved results to be labelled, got:\n%s", component.View())
+	}
+}
+
+func TestTestComponent_CachedRun_RunAnyway(t *testing.T) {
+	// Arrange
+	runs := 0
+	component := newCachedRunComponent(t, &runs)
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	component.Update(cmd())
+	if !strings.Contains(component.View(), "hasn't changed since its last run") {
+		t.Fatalf("Expected the saved run to be offered, got:\n%s", component.View())
+	}
+
+	// Act
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+
+	// Assert
+	if !component.testing {
+		t.Error("Expected [r] to start a run despite the saved results")
+	}
+	if component.cachedOffer != nil {
+		t.Error("Expected the offer to be answered")
+	}
+}
+
+func TestTestComponent_CachedRun_RunsWhenSourcesChanged(t *testing.T) {
+	// Arrange
+	runs := 0
+	component := newCachedRunComponent(t, &runs)
+	component.testRunner.(*MockSourceHashRunner).hash = "def"
+
+	// Act
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	component.Update(cmd())
+
+	// Assert
+	if component.cachedOffer != nil {
+		t.Error("Expected no offer once the sources changed")
+	}
+	if !component.testing {
+		t.Error("Expected the tests to run once the sources changed")
+	}
+}
@@ -2,6 +2,7 @@ package test
 
 import (
 	"404skill-cli/api"
+	"404skill-cli/config"
 	"404skill-cli/testreport"
 	"404skill-cli/testrunner"
 	"context"
@@ -11,9 +12,25 @@ import (
 
 // TestCompleteMsg is sent when testing is complete
 type TestCompleteMsg struct {
+	Project    *testrunner.Project
+	Result     *testreport.ParseResult
+	Error      string
+	ReportPath string                    // set when the run wrote a report that could not be parsed
+	Usage      *testrunner.ResourceUsage // set when the runner sampled the run's resource usage
+	SourceHash string                    // hash of the sources the run tested, empty when not computed
+}
+
+// SingleTestCompleteMsg is sent when re-running a single test is complete
+type SingleTestCompleteMsg struct {
+	Name   string
+	Result *testreport.TestResult
+	Error  string
+}
+
+// ShowStoredResultsMsg opens a project's saved results without recording a new run
+type ShowStoredResultsMsg struct {
 	Project *testrunner.Project
 	Result  *testreport.ParseResult
-	Error   string
 }
 
 // TestProgressMsg is sent during test execution
@@ -29,11 +46,34 @@ type TestErrorMsg struct {
 // ConfigManager interface for project configuration
 type ConfigManager interface {
 	IsProjectDownloaded(projectID string) bool
+	GetCompletionThreshold() float64
+	GetCompletedTasks(projectID string) []int
+	RecordCompletedTasks(projectID string, tasks []int) ([]int, error)
+	GetExcludedTestPatterns() []string
+	RecordRun(projectID string, run config.RunRecord) error
+	GetRunHistory(projectID string) []config.RunRecord
+	IsHistoryRelativeTime() bool
+	SetHistoryRelativeTime(relative bool) error
+	IsCompactResultsHeader() bool
+	SetCompactResultsHeader(compact bool) error
+	GetResultsDir() string
+	IsWatchReportsEnabled() bool
+	IsCacheUnchangedRunsEnabled() bool
 }
 
 // APIClient interface for updating test results
 type APIClient interface {
-	BulkUpdateProfileTests(ctx context.Context, failed []string, passed []string, projectID string) error
+	BulkUpdateProfileTests(ctx context.Context, failed []string, passed []string, projectID string) (*api.BulkUpdateResponse, error)
+}
+
+// Clipboard interface for copying text for the user
+type Clipboard interface {
+	CopyToClipboard(text string) error
+}
+
+// URLOpener interface for opening web pages in the user's browser
+type URLOpener interface {
+	OpenURL(url string) error
 }
 
 // Component interface for tea components
@@ -42,5 +82,7 @@ type Component interface {
 	Update(tea.Msg) (Component, tea.Cmd)
 	View() string
 	SetProjects([]api.Project)
+	SetSize(width, height int)
 	IsShowingTestResults() bool
+	IsViewingLog() bool
 }
@@ -0,0 +1,112 @@
+package test
+
+import (
+	"fmt"
+	"time"
+
+	"404skill-cli/testreport"
+	"404skill-cli/testrunner"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultReportPollInterval is how often the reports directory is checked while results are open
+const defaultReportPollInterval = 2 * time.Second
+
+// reportPollMsg asks for the next check of the watched reports directory
+type reportPollMsg struct {
+	watchID int
+	since   time.Time // write time of the report currently shown
+	err     error     // set when a newer report could not be read
+}
+
+// ReportReloadedMsg is sent when the results view picked up a report written since it opened
+type ReportReloadedMsg struct {
+	watchID int
+	Result  *testreport.ParseResult
+	ModTime time.Time
+}
+
+// watchReports starts reloading the open results whenever the project gets a newer report, when
+// the setting is on and the runner can read reports. Each call supersedes the previous watch.
+func (c *TestComponent) watchReports(project *testrunner.Project) tea.Cmd {
+	c.watchID++
+	c.watching = false
+	c.watchMsg = ""
+	if project == nil || !c.configManager.IsWatchReportsEnabled() {
+		return nil
+	}
+	watcher, ok := c.testRunner.(testrunner.ReportWatcher)
+	if !ok {
+		return nil
+	}
+
+	// The report already shown is the baseline, so only a later one reloads
+	since, _ := watcher.LatestReportTime(*project)
+	c.watching = true
+	return c.pollReportCmd(watcher, *project, since)
+}
+
+// pollReportCmd checks for a newer report after the poll interval
+func (c *TestComponent) pollReportCmd(watcher testrunner.ReportWatcher, project testrunner.Project, since time.Time) tea.Cmd {
+	watchID := c.watchID
+	interval := c.reportPollInterval
+	if interval <= 0 {
+		interval = defaultReportPollInterval
+	}
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return pollReport(watcher, project, since, watchID)
+	})
+}
+
+// pollReport loads the project's newest report if it was written after since. A report that
+// fails to parse may still be being written, so it is tried again once its write time changes.
+func pollReport(watcher testrunner.ReportWatcher, project testrunner.Project, since time.Time, watchID int) tea.Msg {
+	modTime, err := watcher.LatestReportTime(project)
+	if err != nil || !modTime.After(since) {
+		return reportPollMsg{watchID: watchID, since: since}
+	}
+	result, err := watcher.LoadLatestReport(project)
+	if err != nil {
+		return reportPollMsg{watchID: watchID, since: modTime, err: err}
+	}
+	return ReportReloadedMsg{watchID: watchID, Result: result, ModTime: modTime}
+}
+
+// isCurrentWatch reports whether a watch message belongs to the watch of the results still open
+func (c *TestComponent) isCurrentWatch(watchID int) bool {
+	return c.watching && watchID == c.watchID && c.showingTestResults && c.currentProject != nil
+}
+
+// handleReportPoll schedules the next check, or lets the watch end once the results are closed
+func (c *TestComponent) handleReportPoll(msg reportPollMsg) tea.Cmd {
+	if !c.isCurrentWatch(msg.watchID) {
+		return nil
+	}
+	if msg.err != nil {
+		c.watchMsg = "Live: couldn't read the new report: " + msg.err.Error()
+	}
+	watcher := c.testRunner.(testrunner.ReportWatcher)
+	return c.pollReportCmd(watcher, *c.currentProject, msg.since)
+}
+
+// handleReportReloaded shows the reloaded results and keeps watching for the next report. A report
+// written by a single-test rerun holds only that test, so it is skipped: the rerun's result is
+// merged into the full results instead.
+func (c *TestComponent) handleReportReloaded(msg ReportReloadedMsg) tea.Cmd {
+	if !c.isCurrentWatch(msg.watchID) {
+		return nil
+	}
+	watcher := c.testRunner.(testrunner.ReportWatcher)
+	if c.rerunning || !msg.ModTime.After(c.ownReportTime) {
+		return c.pollReportCmd(watcher, *c.currentProject, msg.ModTime)
+	}
+
+	c.currentResult = msg.Result
+	c.usageMsg = ""
+	c.cacheMsg = ""
+	c.testResultsSummary = resultsSummary(msg.Result)
+	c.testResultsComponent.ReloadResults(msg.Result)
+	c.watchMsg = fmt.Sprintf("Live: reloaded the report written at %s", msg.ModTime.Format("15:04:05"))
+	return c.pollReportCmd(watcher, *c.currentProject, msg.ModTime)
+}
@@ -3,6 +3,7 @@ package testresults
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"404skill-cli/testreport"
 
@@ -61,6 +62,14 @@ var (
 			Padding(0, 1).
 			MarginLeft(0)
 
+	regressionStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#ff5555")).
+			Bold(true)
+
+	notCountedStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#888888")).
+			Italic(true)
+
 	helpStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#666666")).
 			Faint(true)
@@ -87,6 +96,7 @@ type DisplayItem struct {
 type GroupHeaderItem struct {
 	Name        string
 	DisplayName string
+	TaskNumber  int
 	PassedCount int
 	FailedCount int
 	TotalTime   float64
@@ -105,10 +115,31 @@ type TestResultsComponent struct {
 	lastSelectedIndex int
 	expandedTests     map[string]bool
 	activeSection     FailureSection
+	rawFailures       bool                    // show failure messages as plain text instead of rendered
+	compactHeader     bool                    // single-line summary, giving the rows back to the list
+	excludedPatterns  []string                // tests matching these are shown but not counted toward progress
+	previous          *testreport.ParseResult // the project's previous run, nil when there is none
+	diff              *testreport.RunDiff     // comparison with the previous run
+	regressionsFirst  bool                    // list regressions in their own group above the others
+	printView         bool                    // plain, fully expanded report for sharing
+	printOffset       int                     // first report line shown in the print view
 
 	// Scrolling
 	visibleStart int // index of first visible item
 	listHeight   int // number of lines available for the list
+	windowHeight int // last known terminal height, 0 until a size is received
+
+	// Jump to task by number
+	jumpBuffer string // digits typed so far
+	jumpSeq    int    // invalidates pending timeouts when more digits arrive
+}
+
+// taskJumpDelay is how long to wait for another digit before jumping (e.g. "1" then "2" for Task 12)
+const taskJumpDelay = 600 * time.Millisecond
+
+// taskJumpTimeoutMsg fires when no further digit was typed within taskJumpDelay
+type taskJumpTimeoutMsg struct {
+	seq int
 }
 
 // Key bindings
@@ -123,6 +154,14 @@ type keyMap struct {
 	PageDown    key.Binding
 	ScrollUp    key.Binding
 	ScrollDown  key.Binding
+	ViewLog     key.Binding
+	JumpToTask  key.Binding
+	Rerun       key.Binding
+	Copy        key.Binding
+	RawFailures key.Binding
+	Header      key.Binding
+	Regressions key.Binding
+	Print       key.Binding
 	Back        key.Binding
 	Quit        key.Binding
 }
@@ -168,6 +207,38 @@ var keys = keyMap{
 		key.WithKeys("ctrl+j", "shift+down"),
 		key.WithHelp("ctrl+j", "scroll down"),
 	),
+	ViewLog: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "view log"),
+	),
+	JumpToTask: key.NewBinding(
+		key.WithKeys("0", "1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		key.WithHelp("0-9", "jump to task"),
+	),
+	Rerun: key.NewBinding(
+		key.WithKeys("r"),
+		key.WithHelp("r", "re-run test"),
+	),
+	Copy: key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "copy failure"),
+	),
+	RawFailures: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "raw/rendered failures"),
+	),
+	Header: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "compact header"),
+	),
+	Regressions: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "regressions first"),
+	),
+	Print: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "print view"),
+	),
 	Back: key.NewBinding(
 		key.WithKeys("esc", "b"),
 		key.WithHelp("esc/b", "back"),
@@ -195,11 +266,88 @@ func (c *TestResultsComponent) Init() tea.Cmd {
 // SetResults sets the test results and builds the display items
 func (c *TestResultsComponent) SetResults(results *testreport.ParseResult) {
 	c.results = results
+	c.updateDiff()
 	c.buildItems()
 	// Ensure selection is on a test item
 	c.ensureValidSelection()
 }
 
+// ReloadResults replaces the results with a newer report of the same project. Unlike
+// SetResults it keeps the view as the user left it: the comparison with the previous run,
+// the expanded failures, the print view and, when the test is still there, the selection.
+func (c *TestResultsComponent) ReloadResults(results *testreport.ParseResult) {
+	var selected *testreport.TestResult
+	if test := c.GetSelectedTest(); test != nil {
+		selectedTest := *test
+		selected = &selectedTest
+	}
+
+	c.results = results
+	c.updateDiff()
+	if c.printView {
+		for _, result := range results.Suite.Results {
+			if !result.Passed {
+				c.expandedTests[result.Name] = true
+			}
+		}
+	}
+	c.buildItems()
+
+	if selected != nil {
+		for i, item := range c.displayItems {
+			if item.Type == ItemTypeTest && item.Test != nil &&
+				item.Test.Result.Name == selected.Name && item.Test.Result.ClassName == selected.ClassName {
+				c.selectedIndex = i
+				c.buildItems()
+				break
+			}
+		}
+	}
+	c.ensureValidSelection()
+}
+
+// SetExcludedPatterns marks tests matching these patterns as not counted toward progress
+func (c *TestResultsComponent) SetExcludedPatterns(patterns []string) {
+	c.excludedPatterns = patterns
+}
+
+// SetPreviousResults compares the results with the project's previous run, so tests that
+// passed then but fail now are marked as regressions. A nil previous run clears the comparison.
+func (c *TestResultsComponent) SetPreviousResults(previous *testreport.ParseResult) {
+	c.previous = previous
+	c.updateDiff()
+	c.buildItems()
+}
+
+// updateDiff compares the current results with the previous run, e.g. after a re-run changed them
+func (c *TestResultsComponent) updateDiff() {
+	if c.previous == nil || c.results == nil {
+		c.diff = nil
+		return
+	}
+	diff := testreport.Diff(c.previous, c.results)
+	c.diff = &diff
+}
+
+// SetRegressionsFirst lists regressions in their own group above every other group
+func (c *TestResultsComponent) SetRegressionsFirst(enabled bool) {
+	c.regressionsFirst = enabled
+	c.selectedIndex = 0
+	c.visibleStart = 0
+	c.buildItems()
+	c.ensureValidSelection()
+}
+
+// IsRegressionsFirst reports whether regressions are listed above the other groups
+func (c *TestResultsComponent) IsRegressionsFirst() bool {
+	return c.regressionsFirst
+}
+
+// isRegression reports whether a test passed in the previous run but fails now
+func (c *TestResultsComponent) isRegression(result testreport.TestResult) bool {
+	return c.diff != nil && c.diff.IsRegression(result)
+}
+
 // ensureValidSelection ensures the selection is on a test item, not a header or divider
 func (c *TestResultsComponent) ensureValidSelection() {
 	if len(c.displayItems) == 0 {
@@ -238,21 +386,58 @@ func (c *TestResultsComponent) GetSelectedTest() *testreport.TestResult {
 	return nil
 }
 
+// SetHeight sizes the list to fit a terminal of the given height
+func (c *TestResultsComponent) SetHeight(height int) {
+	c.windowHeight = height
+	c.updateListHeight()
+}
+
+// SetCompactHeader switches between the full summary header and a single compact line
+func (c *TestResultsComponent) SetCompactHeader(compact bool) {
+	c.compactHeader = compact
+	c.updateListHeight()
+}
+
+// IsCompactHeader reports whether the header is collapsed to a single line
+func (c *TestResultsComponent) IsCompactHeader() bool {
+	return c.compactHeader
+}
+
+// updateListHeight recomputes the rows available to the list from the window height
+func (c *TestResultsComponent) updateListHeight() {
+	if c.windowHeight <= 0 {
+		return
+	}
+	// Reserve 4 lines: header (2), help (1), padding (1); the compact header needs one line less
+	reserved := 4
+	if c.compactHeader {
+		reserved = 3
+	}
+	c.listHeight = c.windowHeight - reserved
+	if c.listHeight < 1 {
+		c.listHeight = 1
+	}
+	// Clamp visibleStart if needed
+	if c.visibleStart > len(c.items)-c.listHeight {
+		c.visibleStart = max(0, len(c.items)-c.listHeight)
+	}
+}
+
 // Update handles incoming messages
 func (c *TestResultsComponent) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		// Reserve 4 lines: header (2), help (1), padding (1)
-		c.listHeight = msg.Height - 4
-		if c.listHeight < 1 {
-			c.listHeight = 1
-		}
-		// Clamp visibleStart if needed
-		if c.visibleStart > len(c.items)-c.listHeight {
-			c.visibleStart = max(0, len(c.items)-c.listHeight)
+		c.SetHeight(msg.Height)
+
+	case taskJumpTimeoutMsg:
+		if msg.seq == c.jumpSeq && c.jumpBuffer != "" {
+			c.jumpToBufferedTask()
 		}
 
 	case tea.KeyMsg:
+		if c.printView {
+			return c, c.updatePrintView(msg)
+		}
 		switch {
 		case key.Matches(msg, keys.Up):
 			c.navigateUp()
@@ -305,6 +490,38 @@ func (c *TestResultsComponent) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, keys.ScrollDown):
 			return c, nil
 
+		case key.Matches(msg, keys.ViewLog):
+			return c, func() tea.Msg { return ViewLogMsg{} }
+
+		case key.Matches(msg, keys.Rerun):
+			if selected := c.GetSelectedTest(); selected != nil {
+				test := *selected
+				return c, func() tea.Msg { return RerunTestMsg{Test: test} }
+			}
+
+		case key.Matches(msg, keys.Copy):
+			if selected := c.GetSelectedTest(); selected != nil {
+				test := *selected
+				return c, func() tea.Msg { return CopyFailureMsg{Test: test} }
+			}
+
+		case key.Matches(msg, keys.RawFailures):
+			c.rawFailures = !c.rawFailures
+
+		case key.Matches(msg, keys.Regressions):
+			c.SetRegressionsFirst(!c.regressionsFirst)
+
+		case key.Matches(msg, keys.Print):
+			c.SetPrintView(true)
+
+		case key.Matches(msg, keys.Header):
+			c.SetCompactHeader(!c.compactHeader)
+			compact := c.compactHeader
+			return c, func() tea.Msg { return HeaderToggledMsg{Compact: compact} }
+
+		case key.Matches(msg, keys.JumpToTask):
+			return c, c.handleJumpDigit(msg.String())
+
 		case key.Matches(msg, keys.Back):
 			return c, func() tea.Msg { return BackToTestListMsg{} }
 
@@ -322,6 +539,10 @@ func (c *TestResultsComponent) View() string {
 		return "No test results available"
 	}
 
+	if c.printView {
+		return c.printViewContent()
+	}
+
 	// Ensure content is always up to date
 	c.buildItems()
 
@@ -356,6 +577,10 @@ func (c *TestResultsComponent) buildItems() {
 	// Build grouped display items
 	c.displayItems = []DisplayItem{}
 
+	if c.regressionsFirst && c.diff != nil && len(c.diff.Regressions) > 0 {
+		c.appendRegressionsGroup()
+	}
+
 	if c.results.GroupedResults != nil {
 		// Use grouped results
 		for groupIndex, group := range c.results.GroupedResults.Classes {
@@ -365,6 +590,7 @@ func (c *TestResultsComponent) buildItems() {
 				Group: &GroupHeaderItem{
 					Name:        group.Name,
 					DisplayName: group.DisplayName,
+					TaskNumber:  group.TaskNumber,
 					PassedCount: group.PassedCount,
 					FailedCount: group.FailedCount,
 					TotalTime:   group.TotalTime,
@@ -375,6 +601,9 @@ func (c *TestResultsComponent) buildItems() {
 
 			// Add tests for this group
 			for _, test := range group.Tests {
+				if c.regressionsFirst && c.isRegression(test) {
+					continue // already listed at the top
+				}
 				testItem := DisplayItem{
 					Type: ItemTypeTest,
 					Test: &TestResultItem{
@@ -418,6 +647,31 @@ func (c *TestResultsComponent) buildItems() {
 	}
 }
 
+// appendRegressionsGroup adds the tests that passed in the previous run but fail now as a
+// group of their own, followed by a divider
+func (c *TestResultsComponent) appendRegressionsGroup() {
+	group := &GroupHeaderItem{
+		Name:        "Regressions",
+		DisplayName: "Regressions (passed in the previous run)",
+		TaskNumber:  -1, // not a task, so number jumps never land here
+		FailedCount: len(c.diff.Regressions),
+	}
+	for _, test := range c.diff.Regressions {
+		group.TotalTime += test.Time
+	}
+	c.displayItems = append(c.displayItems, DisplayItem{Type: ItemTypeGroupHeader, Group: group})
+	for _, test := range c.diff.Regressions {
+		c.displayItems = append(c.displayItems, DisplayItem{
+			Type: ItemTypeTest,
+			Test: &TestResultItem{
+				Result:   test,
+				Expanded: c.expandedTests[test.Name],
+			},
+		})
+	}
+	c.displayItems = append(c.displayItems, DisplayItem{Type: ItemTypeDivider})
+}
+
 // buildHeaderView creates the summary header
 func (c *TestResultsComponent) buildHeaderView() string {
 	if c.results == nil {
@@ -434,6 +688,13 @@ func (c *TestResultsComponent) buildHeaderView() string {
 		"Total: %d   Passed: %d   Failed: %d   Time: %.2fs",
 		testCount, passedCount, failedCount, testTime,
 	)
+	if c.diff != nil && len(c.diff.Regressions) > 0 {
+		summary += "   " + regressionStyle.Render(fmt.Sprintf("Regressions: %d", len(c.diff.Regressions)))
+	}
+
+	if c.compactHeader {
+		return headerStyle.Render(suite.Name) + " " + summary
+	}
 
 	return fmt.Sprintf("%s\n%s",
 		headerStyle.Render("Test Results: "+suite.Name),
@@ -484,11 +745,9 @@ func (c *TestResultsComponent) buildTestListView() string {
 						msg := item.Test.Result.Failure.Message
 						if msg == "" && item.Test.Result.Output != nil && len(item.Test.Result.Output.Stdout) > 0 {
 							msg = strings.SplitN(item.Test.Result.Output.Stdout, "\n", 2)[0]
-						} else if msg != "" {
-							msg = strings.SplitN(msg, "\n", 2)[0]
 						}
 						if msg != "" {
-							b.WriteString(failedStyle.Render("  "+msg) + "\n")
+							b.WriteString(failureContentStyle.Render(renderFailureContent(msg, c.rawFailures)) + "\n")
 						}
 					}
 				}
@@ -536,8 +795,15 @@ func (c *TestResultsComponent) formatTestLine(item TestResultItem) string {
 		}
 	}
 
-	return fmt.Sprintf("%s  %s%s  (%.2fs)",
+	line := fmt.Sprintf("%s  %s%s  (%.2fs)",
 		status, result.Name, expansion, result.Time)
+	if c.isRegression(result) {
+		line += " " + regressionStyle.Render("regressed")
+	}
+	if testreport.IsExcluded(result, c.excludedPatterns) {
+		line += " " + notCountedStyle.Render("not counted")
+	}
+	return line
 }
 
 func (k keyMap) ShortHelp() []key.Binding {
@@ -547,7 +813,7 @@ func (k keyMap) ShortHelp() []key.Binding {
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Expand, k.Collapse, k.Toggle},
-		{k.NextSection, k.Back, k.Quit},
+		{k.NextSection, k.ViewLog, k.JumpToTask, k.Rerun, k.Copy, k.RawFailures, k.Regressions, k.Print, k.Back, k.Quit},
 	}
 }
 
@@ -627,3 +893,70 @@ func (c *TestResultsComponent) navigateDown() {
 		c.buildItems()
 	}
 }
+
+// handleJumpDigit buffers a typed digit and jumps once the task number is unambiguous,
+// otherwise waits briefly for another digit
+func (c *TestResultsComponent) handleJumpDigit(digit string) tea.Cmd {
+	c.jumpBuffer += digit
+	c.jumpSeq++
+
+	if !c.hasLongerTaskNumber(c.jumpBuffer) {
+		c.jumpToBufferedTask()
+		return nil
+	}
+
+	seq := c.jumpSeq
+	return tea.Tick(taskJumpDelay, func(time.Time) tea.Msg {
+		return taskJumpTimeoutMsg{seq: seq}
+	})
+}
+
+// hasLongerTaskNumber reports whether another digit could still select a different task
+func (c *TestResultsComponent) hasLongerTaskNumber(prefix string) bool {
+	for _, item := range c.displayItems {
+		if item.Type != ItemTypeGroupHeader || item.Group == nil {
+			continue
+		}
+		number := fmt.Sprintf("%d", item.Group.TaskNumber)
+		if len(number) > len(prefix) && strings.HasPrefix(number, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// jumpToBufferedTask selects the first test of the buffered task number and clears the buffer
+func (c *TestResultsComponent) jumpToBufferedTask() {
+	var taskNumber int
+	_, err := fmt.Sscanf(c.jumpBuffer, "%d", &taskNumber)
+	c.jumpBuffer = ""
+	if err != nil {
+		return
+	}
+	c.jumpToTask(taskNumber)
+}
+
+// jumpToTask moves the selection to the first test of Task N, returning false if there is no such group
+func (c *TestResultsComponent) jumpToTask(taskNumber int) bool {
+	for headerIndex, item := range c.displayItems {
+		if item.Type != ItemTypeGroupHeader || item.Group == nil || item.Group.TaskNumber != taskNumber {
+			continue
+		}
+
+		for i := headerIndex + 1; i < len(c.displayItems); i++ {
+			if c.displayItems[i].Type == ItemTypeGroupHeader {
+				break
+			}
+			if c.displayItems[i].Type == ItemTypeTest {
+				c.selectedIndex = i
+				c.lastSelectedIndex = i
+				// Keep the group header in view above the selected test
+				c.visibleStart = headerIndex
+				c.buildItems()
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
@@ -1,12 +1,14 @@
 package testresults
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"404skill-cli/testreport"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestNew(t *testing.T) {
@@ -411,6 +413,86 @@ func TestView_ExpandedFailure(t *testing.T) {
 	}
 }
 
+func TestView_FailureContentRawToggle(t *testing.T) {
+	// Arrange
+	const coloured = "\x1b[31mexpected 2\x1b[0m but got \x1b[32m3\x1b[0m"
+	component := New()
+	component.SetResults(&testreport.ParseResult{
+		Suite: testreport.TestSuite{
+			Name: "Test Suite",
+			Results: []testreport.TestResult{
+				{Name: "failed_test", Failure: &testreport.TestFailure{Message: coloured}},
+			},
+		},
+	})
+	component.expandedTests["failed_test"] = true
+	component.buildItems()
+
+	// Act
+	rendered := component.View()
+	component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	raw := component.View()
+
+	// Assert
+	if !strings.Contains(rendered, "\x1b[31mexpected 2") {
+		t.Errorf("Expected ANSI codes to be passed through when rendered, got %q", rendered)
+	}
+	if !component.rawFailures {
+		t.Fatal("Expected m to switch to raw failure output")
+	}
+	if strings.Contains(raw, "\x1b[31m") || !strings.Contains(raw, "expected 2 but got 3") {
+		t.Errorf("Expected ANSI codes to be stripped in raw mode, got %q", raw)
+	}
+}
+
+func TestCompactHeader_IncreasesListHeight(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(&testreport.ParseResult{
+		Suite: testreport.TestSuite{Name: "Test Suite", Tests: 1},
+	})
+	component.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+	fullHeight := component.listHeight
+	fullHeader := component.buildHeaderView()
+
+	// Act
+	_, cmd := component.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("H")})
+
+	// Assert
+	if component.listHeight <= fullHeight {
+		t.Errorf("Expected collapsing the header to grow the list beyond %d rows, got %d", fullHeight, component.listHeight)
+	}
+	if header := component.buildHeaderView(); strings.Contains(header, "\n") || !strings.Contains(fullHeader, "\n") {
+		t.Errorf("Expected the compact header on a single line, got %q (full: %q)", header, fullHeader)
+	}
+	if cmd == nil {
+		t.Fatal("Expected a command reporting the new header preference")
+	}
+	if msg, ok := cmd().(HeaderToggledMsg); !ok || !msg.Compact {
+		t.Errorf("Expected HeaderToggledMsg{Compact: true}, got %#v", msg)
+	}
+}
+
+func TestRenderFailureContent_Markdown(t *testing.T) {
+	// Arrange
+	msg := "**mismatch** in `Sum`\n```diff\n-want 2\n+got 3\n```"
+
+	// Act
+	rendered := renderFailureContent(msg, false)
+	raw := renderFailureContent(msg, true)
+
+	// Assert
+	if strings.Contains(rendered, "```") || strings.Contains(rendered, "**") || strings.Contains(rendered, "`Sum`") {
+		t.Errorf("Expected markdown markers to be rendered, got %q", rendered)
+	}
+	if !strings.Contains(rendered, "mismatch") || !strings.Contains(rendered, "-want 2") || !strings.Contains(rendered, "+got 3") {
+		t.Errorf("Expected rendered content to keep its text, got %q", rendered)
+	}
+	if raw != msg {
+		t.Errorf("Expected raw content to be unchanged, got %q", raw)
+	}
+}
+
 func TestFormatTestLine(t *testing.T) {
 	component := New()
 
@@ -642,3 +724,400 @@ func TestGroupedToggleExpansion(t *testing.T) {
 		t.Error("Expected test to be collapsed after second toggle")
 	}
 }
+
+// numberedTaskResults builds grouped results for the given task numbers, one test per task
+func numberedTaskResults(taskNumbers ...int) *testreport.ParseResult {
+	results := &testreport.ParseResult{
+		Suite:          testreport.TestSuite{Name: "Test Suite"},
+		GroupedResults: &testreport.GroupedTestResults{},
+	}
+	for _, n := range taskNumbers {
+		test := testreport.TestResult{Name: fmt.Sprintf("test_task%d", n), ClassName: fmt.Sprintf("test_api.TestTask%d", n), Passed: true}
+		results.Suite.Results = append(results.Suite.Results, test)
+		results.GroupedResults.Classes = append(results.GroupedResults.Classes, testreport.TestClass{
+			Name:        fmt.Sprintf("Task%d", n),
+			DisplayName: fmt.Sprintf("Task %d", n),
+			TaskNumber:  n,
+			Tests:       []testreport.TestResult{test},
+			PassedCount: 1,
+		})
+	}
+	return results
+}
+
+func pressKey(c *TestResultsComponent, k string) tea.Cmd {
+	_, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)})
+	return cmd
+}
+
+func TestJumpToTask_SingleDigit(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(numberedTaskResults(1, 2, 3))
+
+	// Act
+	cmd := pressKey(component, "3")
+
+	// Assert
+	if cmd != nil {
+		t.Error("Expected an unambiguous digit to jump immediately")
+	}
+	selected := component.GetSelectedTest()
+	if selected == nil || selected.Name != "test_task3" {
+		t.Errorf("Expected Task 3's first test to be selected, got %v", selected)
+	}
+}
+
+func TestJumpToTask_MultiDigit(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(numberedTaskResults(1, 2, 12))
+
+	// Act
+	cmd := pressKey(component, "1")
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected \"1\" to wait for a possible second digit")
+	}
+	if selected := component.GetSelectedTest(); selected.Name != "test_task1" {
+		t.Errorf("Expected selection unchanged while waiting, got %s", selected.Name)
+	}
+
+	// Act
+	pressKey(component, "2")
+
+	// Assert
+	if selected := component.GetSelectedTest(); selected == nil || selected.Name != "test_task12" {
+		t.Errorf("Expected Task 12 to be selected, got %v", selected)
+	}
+	if component.jumpBuffer != "" {
+		t.Errorf("Expected jump buffer to be cleared, got %q", component.jumpBuffer)
+	}
+}
+
+func TestJumpToTask_Timeout(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(numberedTaskResults(1, 2, 12))
+	pressKey(component, "2") // move away from Task 1
+	pressKey(component, "1")
+
+	// Act
+	component.Update(taskJumpTimeoutMsg{seq: component.jumpSeq})
+
+	// Assert
+	if selected := component.GetSelectedTest(); selected == nil || selected.Name != "test_task1" {
+		t.Errorf("Expected Task 1 to be selected after the timeout, got %v", selected)
+	}
+}
+
+func TestJumpToTask_StaleTimeoutIgnored(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(numberedTaskResults(1, 2, 12))
+	pressKey(component, "2")
+	pressKey(component, "1")
+	staleSeq := component.jumpSeq - 1
+
+	// Act
+	component.Update(taskJumpTimeoutMsg{seq: staleSeq})
+
+	// Assert
+	if component.jumpBuffer != "1" {
+		t.Errorf("Expected buffered digit to survive a stale timeout, got %q", component.jumpBuffer)
+	}
+	if selected := component.GetSelectedTest(); selected.Name != "test_task2" {
+		t.Errorf("Expected selection unchanged, got %s", selected.Name)
+	}
+}
+
+func TestJumpToTask_UnknownTask(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(numberedTaskResults(1, 2))
+
+	// Act
+	pressKey(component, "7")
+
+	// Assert
+	if selected := component.GetSelectedTest(); selected.Name != "test_task1" {
+		t.Errorf("Expected selection unchanged for a missing task, got %s", selected.Name)
+	}
+	if component.jumpBuffer != "" {
+		t.Errorf("Expected jump buffer to be cleared, got %q", component.jumpBuffer)
+	}
+}
+
+// setPassed marks a test of numberedTaskResults as passing or failing, in the suite and its group
+func setPassed(results *testreport.ParseResult, taskNumber int, passed bool) {
+	for i := range results.Suite.Results {
+		if results.Suite.Results[i].ClassName == fmt.Sprintf("test_api.TestTask%d", taskNumber) {
+			results.Suite.Results[i].Passed = passed
+		}
+	}
+	for i := range results.GroupedResults.Classes {
+		if results.GroupedResults.Classes[i].TaskNumber == taskNumber {
+			results.GroupedResults.Classes[i].Tests[0].Passed = passed
+		}
+	}
+}
+
+// testNames lists the tests in display order
+func testNames(c *TestResultsComponent) []string {
+	var names []string
+	for _, item := range c.displayItems {
+		if item.Type == ItemTypeTest {
+			names = append(names, item.Test.Result.Name)
+		}
+	}
+	return names
+}
+
+func TestRegressionsFirst_SortsRegressionsAheadOfExistingFailures(t *testing.T) {
+	// Arrange: task 1 failed before and still fails, task 3 passed before and now fails
+	previous := numberedTaskResults(1, 2, 3)
+	setPassed(previous, 1, false)
+	current := numberedTaskResults(1, 2, 3)
+	setPassed(current, 1, false)
+	setPassed(current, 3, false)
+
+	component := New()
+	component.SetResults(current)
+	component.SetPreviousResults(previous)
+
+	// Act
+	pressKey(component, "R")
+
+	// Assert
+	names := testNames(component)
+	expected := []string{"test_task3", "test_task1", "test_task2"}
+	if strings.Join(names, ",") != strings.Join(expected, ",") {
+		t.Fatalf("Expected order %v, got %v", expected, names)
+	}
+	if header := component.displayItems[0]; header.Type != ItemTypeGroupHeader || header.Group.Name != "Regressions" {
+		t.Errorf("Expected the regressions group first, got %+v", header)
+	}
+	if got := component.GetSelectedTest(); got == nil || got.Name != "test_task3" {
+		t.Errorf("Expected the first regression to be selected, got %+v", got)
+	}
+	view := component.View()
+	if !strings.Contains(view, "regressed") || !strings.Contains(view, "Regressions: 1") {
+		t.Errorf("Expected the regression to be marked, got:\n%s", view)
+	}
+}
+
+func TestRegressionsFirst_ToggleRestoresTaskOrder(t *testing.T) {
+	// Arrange
+	previous := numberedTaskResults(1, 2)
+	current := numberedTaskResults(1, 2)
+	setPassed(current, 2, false)
+
+	component := New()
+	component.SetResults(current)
+	component.SetPreviousResults(previous)
+	pressKey(component, "R")
+
+	// Act
+	pressKey(component, "R")
+
+	// Assert
+	if names := testNames(component); strings.Join(names, ",") != "test_task1,test_task2" {
+		t.Errorf("Expected task order after toggling off, got %v", names)
+	}
+	if component.IsRegressionsFirst() {
+		t.Error("Expected regressions first to be off")
+	}
+}
+
+func TestReloadResults_KeepsComparisonAndSelection(t *testing.T) {
+	// Arrange: task 3 regressed and the user has it selected and expanded
+	previous := numberedTaskResults(1, 2, 3)
+	current := numberedTaskResults(1, 2, 3)
+	setPassed(current, 3, false)
+	component := New()
+	component.SetResults(current)
+	component.SetPreviousResults(previous)
+	pressKey(component, "3")
+	component.expandedTests["test_task3"] = true
+
+	// Act: a newer report where task 2 regressed as well
+	reloaded := numberedTaskResults(1, 2, 3)
+	setPassed(reloaded, 2, false)
+	setPassed(reloaded, 3, false)
+	component.ReloadResults(reloaded)
+
+	// Assert
+	if got := component.GetSelectedTest(); got == nil || got.Name != "test_task3" {
+		t.Errorf("Expected test_task3 to stay selected, got %+v", got)
+	}
+	if !component.expandedTests["test_task3"] {
+		t.Error("Expected test_task3 to stay expanded")
+	}
+	if component.diff == nil || len(component.diff.Regressions) != 2 {
+		t.Errorf("Expected both regressions against the previous run, got %+v", component.diff)
+	}
+}
+
+func TestRegressionsFirst_NoPreviousRun(t *testing.T) {
+	// Arrange
+	current := numberedTaskResults(1, 2)
+	setPassed(current, 2, false)
+	component := New()
+	component.SetResults(current)
+
+	// Act
+	pressKey(component, "R")
+
+	// Assert
+	if names := testNames(component); strings.Join(names, ",") != "test_task1,test_task2" {
+		t.Errorf("Expected task order without a previous run, got %v", names)
+	}
+	if strings.Contains(component.View(), "regressed") {
+		t.Error("Expected nothing marked as regressed without a previous run")
+	}
+}
+
+func TestFormatFailureReport(t *testing.T) {
+	// Arrange
+	result := testreport.TestResult{
+		Name:      "test_create_entry",
+		ClassName: "test_api.TestTask2JournalEntry",
+		Time:      0.7,
+		Failure: &testreport.TestFailure{
+			Message: "assert 404 == 201",
+			Type:    "AssertionError",
+			Content: "Traceback (most recent call last):\n  File \"test_api.py\", line 12\nAssertionError\n",
+		},
+	}
+
+	// Act
+	report := FormatFailureReport(result)
+
+	// Assert
+	expected := `Test:     test_create_entry
+Class:    test_api.TestTask2JournalEntry
+Duration: 0.70s
+Status:   FAILED (AssertionError)
+
+Message:
+  assert 404 == 201
+
+Details:
+  Traceback (most recent call last):
+    File "test_api.py", line 12
+  AssertionError
+`
+	if report != expected {
+		t.Errorf("Unexpected report:\n%s\nexpected:\n%s", report, expected)
+	}
+}
+
+func TestUpdate_CopyKeySendsSelectedTest(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(numberedTaskResults(1, 2))
+
+	// Act
+	cmd := pressKey(component, "y")
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected a copy command")
+	}
+	msg, ok := cmd().(CopyFailureMsg)
+	if !ok || msg.Test.Name != "test_task1" {
+		t.Errorf("Expected CopyFailureMsg for test_task1, got %+v", msg)
+	}
+}
+
+// failingTaskResults returns one passing and two failing tasks, the second with coloured output
+func failingTaskResults() *testreport.ParseResult {
+	results := numberedTaskResults(1, 2, 3)
+	for i, message := range map[int]string{1: "assert 404 == 201", 2: "\x1b[31mexpected 3 entries\x1b[0m\ngot 2"} {
+		results.Suite.Results[i].Passed = false
+		results.Suite.Results[i].Failure = &testreport.TestFailure{Message: message, Type: "AssertionError"}
+		class := &results.GroupedResults.Classes[i]
+		class.Tests[0] = results.Suite.Results[i]
+		class.PassedCount, class.FailedCount = 0, 1
+	}
+	results.PassedTests = []string{"test_task1"}
+	results.FailedTests = []string{"test_task2", "test_task3"}
+	return results
+}
+
+func TestPrintView_ExpandsAllFailuresAsPlainText(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(failingTaskResults())
+
+	// Act
+	pressKey(component, "p")
+	view := component.View()
+
+	// Assert
+	if !component.IsPrintView() {
+		t.Fatal("Expected the print view to be shown")
+	}
+	for _, name := range []string{"test_task2", "test_task3"} {
+		if !component.expandedTests[name] {
+			t.Errorf("Expected %s to be expanded", name)
+		}
+	}
+	for _, want := range []string{"[FAIL]  test_task2", "      assert 404 == 201", "      expected 3 entries", "      got 2", "[PASS]  test_task1"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q in the print view, got:\n%s", want, view)
+		}
+	}
+	if ansi.Strip(view) != view {
+		t.Errorf("Expected plain output without colours or highlight, got:\n%q", view)
+	}
+}
+
+func TestPrintView_CopiesWholeReport(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(failingTaskResults())
+	component.SetHeight(6) // too short to show everything at once
+	pressKey(component, "p")
+
+	// Act
+	cmd := pressKey(component, "y")
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected a copy command")
+	}
+	msg, ok := cmd().(CopyReportMsg)
+	if !ok {
+		t.Fatalf("Expected CopyReportMsg, got %T", cmd())
+	}
+	if !strings.HasPrefix(msg.Text, "Test Results: Test Suite\n") || !strings.Contains(msg.Text, "got 2") {
+		t.Errorf("Expected the full report to be copied, got:\n%s", msg.Text)
+	}
+}
+
+func TestPrintView_ScrollsAndLeaves(t *testing.T) {
+	// Arrange
+	component := New()
+	component.SetResults(failingTaskResults())
+	component.SetHeight(6)
+	pressKey(component, "p")
+	first := component.View()
+
+	// Act
+	pressKey(component, "j")
+	scrolled := component.View()
+	pressKey(component, "p")
+
+	// Assert
+	if first == scrolled {
+		t.Error("Expected scrolling to move the print view")
+	}
+	if component.IsPrintView() {
+		t.Error("Expected p to leave the print view")
+	}
+	if !component.expandedTests["test_task2"] || !component.expandedTests["test_task3"] {
+		t.Error("Expected failures to stay expanded after leaving the print view")
+	}
+}
@@ -0,0 +1,49 @@
+package testresults
+
+import (
+	"fmt"
+	"strings"
+
+	"404skill-cli/testreport"
+)
+
+// FormatFailureReport lays out a test result as a self-contained block for pasting into an
+// issue or chat: name, class, duration and status, then the failure message and its details
+func FormatFailureReport(result testreport.TestResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Test:     %s\n", result.Name)
+	if result.ClassName != "" {
+		fmt.Fprintf(&b, "Class:    %s\n", result.ClassName)
+	}
+	fmt.Fprintf(&b, "Duration: %.2fs\n", result.Time)
+
+	status := "PASSED"
+	if !result.Passed {
+		status = "FAILED"
+		if result.Failure != nil && result.Failure.Type != "" {
+			status += " (" + result.Failure.Type + ")"
+		}
+	}
+	fmt.Fprintf(&b, "Status:   %s\n", status)
+
+	if result.Failure != nil {
+		writeIndentedSection(&b, "Message", result.Failure.Message)
+		writeIndentedSection(&b, "Details", result.Failure.Content)
+	}
+	if result.Output != nil {
+		writeIndentedSection(&b, "Stderr", result.Output.Stderr)
+	}
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
+
+// writeIndentedSection adds a titled section with its text indented, skipping empty text
+func writeIndentedSection(b *strings.Builder, title, text string) {
+	text = strings.Trim(text, "\n")
+	if strings.TrimSpace(text) == "" {
+		return
+	}
+	fmt.Fprintf(b, "\n%s:\n", title)
+	for _, line := range strings.Split(text, "\n") {
+		b.WriteString("  " + strings.TrimRight(line, " \t\r") + "\n")
+	}
+}
@@ -0,0 +1,164 @@
+package testresults
+
+import (
+	"fmt"
+	"strings"
+
+	"404skill-cli/testreport"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// printDivider separates groups in the print view
+const printDivider = "----------------------------------------"
+
+// SetPrintView switches to the print view: every failure is expanded and the whole report is
+// shown as plain text, without colours or a selection highlight, ready to scroll or copy
+func (c *TestResultsComponent) SetPrintView(enabled bool) {
+	c.printView = enabled
+	c.printOffset = 0
+	if !enabled || c.results == nil {
+		return
+	}
+	for _, result := range c.results.Suite.Results {
+		if !result.Passed {
+			c.expandedTests[result.Name] = true
+		}
+	}
+	c.buildItems()
+}
+
+// IsPrintView reports whether the print view is shown
+func (c *TestResultsComponent) IsPrintView() bool {
+	return c.printView
+}
+
+// PrintReport lays out the results as plain text in list order: the summary, then each group
+// with its tests and every failure message in full
+func (c *TestResultsComponent) PrintReport() string {
+	if c.results == nil {
+		return ""
+	}
+	c.buildItems()
+
+	suite := c.results.Suite
+	var b strings.Builder
+	fmt.Fprintf(&b, "Test Results: %s\n", suite.Name)
+	fmt.Fprintf(&b, "Total: %d   Passed: %d   Failed: %d   Time: %.2fs",
+		suite.Tests, len(c.results.PassedTests), len(c.results.FailedTests), suite.Time)
+	if c.diff != nil && len(c.diff.Regressions) > 0 {
+		fmt.Fprintf(&b, "   Regressions: %d", len(c.diff.Regressions))
+	}
+	b.WriteString("\n")
+
+	for _, item := range c.displayItems {
+		switch item.Type {
+		case ItemTypeGroupHeader:
+			if item.Group != nil {
+				group := item.Group
+				fmt.Fprintf(&b, "\n%s (%d passed, %d failed, %.2fs)\n",
+					group.DisplayName, group.PassedCount, group.FailedCount, group.TotalTime)
+			}
+		case ItemTypeTest:
+			if item.Test != nil {
+				c.printTest(&b, item.Test.Result)
+			}
+		case ItemTypeDivider:
+			b.WriteString(printDivider + "\n")
+		}
+	}
+	return b.String()
+}
+
+// printTest writes a test line, followed by its failure message and details when it failed
+func (c *TestResultsComponent) printTest(b *strings.Builder, result testreport.TestResult) {
+	status := "[PASS]"
+	if !result.Passed {
+		status = "[FAIL]"
+	}
+	fmt.Fprintf(b, "  %s  %s  (%.2fs)", status, result.Name, result.Time)
+	if c.isRegression(result) {
+		b.WriteString("  regressed")
+	}
+	b.WriteString("\n")
+
+	if result.Passed || result.Failure == nil {
+		return
+	}
+	texts := []string{printableText(result.Failure.Message)}
+	if details := printableText(result.Failure.Content); details != texts[0] {
+		texts = append(texts, details)
+	}
+	for _, text := range texts {
+		if text == "" {
+			continue
+		}
+		for _, line := range strings.Split(text, "\n") {
+			b.WriteString("      " + line + "\n")
+		}
+	}
+}
+
+// printableText strips colours and surrounding blank lines from failure text
+func printableText(text string) string {
+	text = ansi.Strip(strings.ReplaceAll(text, "\r\n", "\n"))
+	lines := strings.Split(strings.Trim(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	if text = strings.Join(lines, "\n"); strings.TrimSpace(text) == "" {
+		return ""
+	}
+	return text
+}
+
+// printPageHeight is how many report lines fit above the print view's help line
+func (c *TestResultsComponent) printPageHeight() int {
+	if c.windowHeight <= 0 {
+		return 0 // size unknown, show everything
+	}
+	return max(c.windowHeight-2, 1)
+}
+
+// scrollPrint moves the print view by delta lines, keeping the last page in view
+func (c *TestResultsComponent) scrollPrint(delta int) {
+	lines := strings.Count(c.PrintReport(), "\n")
+	limit := max(lines-c.printPageHeight(), 0)
+	c.printOffset = min(max(c.printOffset+delta, 0), limit)
+}
+
+// printViewContent renders the visible part of the print view
+func (c *TestResultsComponent) printViewContent() string {
+	lines := strings.Split(strings.TrimRight(c.PrintReport(), "\n"), "\n")
+	if height := c.printPageHeight(); height > 0 {
+		start := min(c.printOffset, max(len(lines)-1, 0))
+		lines = lines[start:min(start+height, len(lines))]
+	}
+	return strings.Join(lines, "\n") + "\n\n[p] leave print view • [y] copy all • ↑/↓ u/d scroll"
+}
+
+// updatePrintView handles keys while the print view is shown
+func (c *TestResultsComponent) updatePrintView(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, keys.Up):
+		c.scrollPrint(-1)
+	case key.Matches(msg, keys.Down):
+		c.scrollPrint(1)
+	case key.Matches(msg, keys.PageUp):
+		c.scrollPrint(-max(c.printPageHeight(), 1))
+	case key.Matches(msg, keys.PageDown):
+		c.scrollPrint(max(c.printPageHeight(), 1))
+	case key.Matches(msg, keys.Copy):
+		text := c.PrintReport()
+		return func() tea.Msg { return CopyReportMsg{Text: text} }
+	case key.Matches(msg, keys.Print):
+		c.SetPrintView(false)
+	case key.Matches(msg, keys.Back):
+		return func() tea.Msg { return BackToTestListMsg{} }
+	case key.Matches(msg, keys.Quit):
+		return tea.Quit
+	}
+	return nil
+}
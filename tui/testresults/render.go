@@ -0,0 +1,60 @@
+package testresults
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+var (
+	diffAddedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#00ff00"))
+	diffRemovedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff5555"))
+	inlineCodeStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("#00ffaa"))
+	strongStyle      = lipgloss.NewStyle().Bold(true)
+
+	strongPattern     = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	inlineCodePattern = regexp.MustCompile("`([^`]+)`")
+)
+
+// renderFailureContent formats a failure message for the expanded failure block.
+// Raw mode shows the message as plain text with any ANSI codes stripped; otherwise
+// ANSI codes are passed through and light markdown (fences, bold, inline code, diffs)
+// is rendered so rich assertion output reads as intended.
+func renderFailureContent(msg string, raw bool) string {
+	msg = strings.TrimRight(strings.ReplaceAll(msg, "\r\n", "\n"), "\n")
+	if raw {
+		return ansi.Strip(msg)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(msg, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			continue
+		}
+		lines = append(lines, renderFailureLine(line))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderFailureLine renders a single line, leaving lines that already carry ANSI styling untouched
+func renderFailureLine(line string) string {
+	if ansi.Strip(line) != line {
+		return line
+	}
+
+	switch {
+	case strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "+++"):
+		return diffAddedStyle.Render(line)
+	case strings.HasPrefix(line, "-") && !strings.HasPrefix(line, "---"):
+		return diffRemovedStyle.Render(line)
+	}
+
+	line = strongPattern.ReplaceAllStringFunc(line, func(m string) string {
+		return strongStyle.Render(strongPattern.FindStringSubmatch(m)[1])
+	})
+	return inlineCodePattern.ReplaceAllStringFunc(line, func(m string) string {
+		return inlineCodeStyle.Render(inlineCodePattern.FindStringSubmatch(m)[1])
+	})
+}
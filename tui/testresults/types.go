@@ -23,6 +23,29 @@ type ToggleExpansionMsg struct {
 // BackToTestListMsg is sent when user wants to return to test list
 type BackToTestListMsg struct{}
 
+// ViewLogMsg is sent when user wants to view the raw log of the test run
+type ViewLogMsg struct{}
+
+// RerunTestMsg is sent when user wants to re-run only the selected test
+type RerunTestMsg struct {
+	Test testreport.TestResult
+}
+
+// CopyFailureMsg is sent when the user copies the selected test's failure report
+type CopyFailureMsg struct {
+	Test testreport.TestResult
+}
+
+// CopyReportMsg is sent when the user copies the whole print view
+type CopyReportMsg struct {
+	Text string
+}
+
+// HeaderToggledMsg is sent when the user collapses or expands the summary header
+type HeaderToggledMsg struct {
+	Compact bool
+}
+
 // NavigateToSectionMsg is sent when user navigates between failure sections
 type NavigateToSectionMsg struct {
 	Section FailureSection
@@ -6,6 +6,8 @@ import (
 	"os/exec"
 	"runtime"
 	"strings"
+
+	"404skill-cli/homedir"
 )
 
 // Theme represents the detected terminal theme
@@ -205,7 +207,7 @@ func (d *Detector) detectGTKTheme() Theme {
 // detectKDETheme detects KDE theme on Linux
 func (d *Detector) detectKDETheme() Theme {
 	// Check KDE configuration
-	homeDir, err := os.UserHomeDir()
+	homeDir, err := homedir.Dir()
 	if err != nil {
 		return ThemeUnknown
 	}
@@ -30,10 +30,15 @@ func InitialModel(client api.ClientInterface, version string) (Model, error) {
 		return Model{}, err
 	}
 
+	return NewModel(ctrl, tuiTracer), nil
+}
+
+// NewModel wraps an existing controller, such as one built with test doubles
+func NewModel(ctrl *controller.Controller, tracer *tracing.TUIIntegration) Model {
 	return Model{
 		controller: ctrl,
-		tracer:     tuiTracer,
-	}, nil
+		tracer:     tracer,
+	}
 }
 
 // Init initializes the model and returns initial commands
@@ -0,0 +1,218 @@
+package tui
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"404skill-cli/api"
+	"404skill-cli/auth"
+	"404skill-cli/config"
+	"404skill-cli/downloader"
+	"404skill-cli/tui/controller"
+	"404skill-cli/tui/tuitest"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MockAuthProvider implements auth.AuthProvider for testing
+type MockAuthProvider struct {
+	token string
+	err   error
+}
+
+func (m *MockAuthProvider) SignIn(ctx context.Context, username, password string) (string, error) {
+	return m.token, m.err
+}
+
+// MockClient implements api.ClientInterface for testing
+type MockClient struct {
+	projects []api.Project
+}
+
+func (m *MockClient) ListProjects(ctx context.Context) ([]api.Project, error) {
+	return m.projects, nil
+}
+
+func (m *MockClient) InitializeProject(ctx context.Context, projectID string) error {
+	return nil
+}
+
+func (m *MockClient) BulkUpdateProfileTests(ctx context.Context, failed, passed []string, projectID string) (*api.BulkUpdateResponse, error) {
+	return &api.BulkUpdateResponse{}, nil
+}
+
+// MockDownloader records downloads and marks them as done in the config
+type MockDownloader struct {
+	mu            sync.Mutex
+	configManager *config.ConfigManager
+	downloaded    []string
+}
+
+func (m *MockDownloader) DownloadProject(ctx context.Context, project *api.Project, language string, progressCallback downloader.ProgressCallback) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.downloaded = append(m.downloaded, project.ID)
+	progressCallback(1.0)
+	return m.configManager.UpdateDownloadedProject(project.ID)
+}
+
+// stubVersionChecker never reports an update, keeping tests off the network
+type stubVersionChecker struct{}
+
+func (s stubVersionChecker) CheckForUpdates(ctx context.Context) controller.VersionInfo {
+	return controller.VersionInfo{CurrentVersion: "test"}
+}
+
+var testProjects = []api.Project{
+	{ID: "p1", Name: "Key Value Store", Language: "go", Difficulty: "medium", Description: "Build a KV store", Technologies: "Go"},
+	{ID: "p2", Name: "Key Value Store", Language: "java", Difficulty: "medium", Description: "Build a KV store in Java", Technologies: "Java"},
+}
+
+// setupConfig points the config at a temp file for the duration of the test
+func setupConfig(t *testing.T) {
+	t.Helper()
+	originalPath := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() {
+		config.ConfigFilePath = originalPath
+	})
+}
+
+// newHarness builds the full TUI model with test doubles and wraps it in a harness
+func newHarness(t *testing.T, authProvider auth.AuthProvider, dl *MockDownloader) *tuitest.Harness {
+	t.Helper()
+	configManager := config.NewConfigManager(auth.NewAuthService(authProvider, &config.SimpleConfigWriter{}))
+	if dl != nil {
+		dl.configManager = configManager
+	}
+
+	deps := controller.Dependencies{
+		AuthProvider:   authProvider,
+		ConfigManager:  configManager,
+		VersionChecker: stubVersionChecker{},
+	}
+	if dl != nil {
+		deps.Downloader = dl
+	}
+
+	ctrl, err := controller.NewWithDependencies(&MockClient{projects: testProjects}, "test", nil, deps)
+	if err != nil {
+		t.Fatalf("Failed to create controller: %v", err)
+	}
+	return tuitest.New(NewModel(ctrl, nil)).Init()
+}
+
+func TestEndToEnd_LoginToMainMenu(t *testing.T) {
+	// Arrange
+	setupConfig(t)
+	h := newHarness(t, &MockAuthProvider{token: "token-123"}, nil)
+	if !h.ViewContains("Username") {
+		t.Fatalf("Expected login screen, got:\n%s", h.View())
+	}
+
+	// Act
+	h.Type("alice").Press(tea.KeyTab).Type("secret").Press(tea.KeyEnter)
+
+	// Assert
+	if !h.ViewContains("Download a project") || !h.ViewContains("Test a project") {
+		t.Errorf("Expected main menu after login, got:\n%s", h.View())
+	}
+	if !config.NewConfigManager(nil).HasCredentials() {
+		t.Error("Expected credentials to be saved after login")
+	}
+}
+
+func TestEndToEnd_LoginFailureStaysOnLogin(t *testing.T) {
+	// Arrange
+	setupConfig(t)
+	h := newHarness(t, &MockAuthProvider{err: context.DeadlineExceeded}, nil)
+
+	// Act
+	h.Type("alice").Press(tea.KeyTab).Type("wrong").Press(tea.KeyEnter)
+
+	// Assert
+	if h.ViewContains("Download a project") {
+		t.Error("Expected to remain on the login screen after a failed login")
+	}
+	if !h.ViewContains("Username") {
+		t.Errorf("Expected login screen, got:\n%s", h.View())
+	}
+}
+
+func TestEndToEnd_LoginThenDownload(t *testing.T) {
+	// Arrange
+	setupConfig(t)
+	dl := &MockDownloader{}
+	h := newHarness(t, &MockAuthProvider{token: "token-123"}, dl)
+
+	// Act - log in, pick "Download a project", the project name and its first variant
+	h.Type("alice").Press(tea.KeyTab).Type("secret").Press(tea.KeyEnter)
+	h.Press(tea.KeyEnter)
+	if !h.ViewContains("Key Value Store") {
+		t.Fatalf("Expected project name menu, got:\n%s", h.View())
+	}
+	h.Press(tea.KeyEnter)
+	if !h.ViewContains("Build a KV store") {
+		t.Fatalf("Expected variant table, got:\n%s", h.View())
+	}
+	h.Press(tea.KeyEnter)
+
+	// Assert
+	if len(dl.downloaded) != 1 || dl.downloaded[0] != "p1" {
+		t.Fatalf("Expected project p1 to be downloaded, got %v", dl.downloaded)
+	}
+	if !h.ViewContains("✓") {
+		t.Errorf("Expected variant to be marked downloaded, got:\n%s", h.View())
+	}
+}
+
+func TestEndToEnd_QuitFromMainMenu(t *testing.T) {
+	// Arrange
+	setupConfig(t)
+	h := newHarness(t, &MockAuthProvider{token: "token-123"}, nil)
+	h.Type("alice").Press(tea.KeyTab).Type("secret").Press(tea.KeyEnter)
+
+	// Act
+	h.Type("q")
+
+	// Assert
+	if !h.Quit() {
+		t.Error("Expected the program to quit")
+	}
+}
+
+func TestDemoMode_PopulatesProjectsWithoutNetwork(t *testing.T) {
+	// Arrange
+	setupConfig(t)
+	if err := os.WriteFile(config.ConfigFilePath, []byte("{}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	originalOverride := config.ProjectsDirOverride
+	config.ProjectsDirOverride = t.TempDir()
+	t.Cleanup(func() { config.ProjectsDirOverride = originalOverride })
+
+	model, err := InitialDemoModel("test")
+	if err != nil {
+		t.Fatalf("Failed to create demo model: %v", err)
+	}
+	h := tuitest.New(model).Init()
+	if !h.ViewContains("Download a project") {
+		t.Fatalf("Expected demo mode to skip login, got:\n%s", h.View())
+	}
+
+	// Act
+	h.Press(tea.KeyEnter)
+
+	// Assert
+	for _, name := range []string{"Key Value Store", "Journal API", "Rate Limiter"} {
+		if !h.ViewContains(name) {
+			t.Errorf("Expected sample project %q, got:\n%s", name, h.View())
+		}
+	}
+	if config.NewConfigManager(nil).HasCredentials() {
+		t.Error("Expected demo mode not to store credentials")
+	}
+}
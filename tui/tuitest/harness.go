@@ -0,0 +1,143 @@
+// Package tuitest drives Bubble Tea models without a terminal so whole flows
+// can be exercised from tests with scripted input.
+package tuitest
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DefaultCommandTimeout is how long the harness waits for a command to produce a message.
+// Timers such as spinners and periodic checks take longer and are dropped.
+const DefaultCommandTimeout = 25 * time.Millisecond
+
+// DefaultMaxSteps bounds how many follow-up messages a single input may trigger
+const DefaultMaxSteps = 100
+
+// Harness feeds messages into a model, resolves the commands it returns and
+// records the rendered view after each message
+type Harness struct {
+	model          tea.Model
+	views          []string
+	quit           bool
+	CommandTimeout time.Duration
+	MaxSteps       int
+}
+
+// New creates a harness around the given model
+func New(model tea.Model) *Harness {
+	return &Harness{
+		model:          model,
+		CommandTimeout: DefaultCommandTimeout,
+		MaxSteps:       DefaultMaxSteps,
+	}
+}
+
+// Init runs the model's Init command and delivers the resulting messages
+func (h *Harness) Init() *Harness {
+	h.process(h.resolve(h.model.Init()))
+	return h
+}
+
+// Send delivers each message in order, along with every message its commands produce
+func (h *Harness) Send(msgs ...tea.Msg) *Harness {
+	for _, msg := range msgs {
+		if h.quit {
+			return h
+		}
+		h.process([]tea.Msg{msg})
+	}
+	return h
+}
+
+// Type sends each rune of the text as a key press
+func (h *Harness) Type(text string) *Harness {
+	for _, r := range text {
+		h.Send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return h
+}
+
+// Press sends special keys such as tea.KeyEnter or tea.KeyTab
+func (h *Harness) Press(keys ...tea.KeyType) *Harness {
+	for _, k := range keys {
+		h.Send(tea.KeyMsg{Type: k})
+	}
+	return h
+}
+
+// View returns the most recently rendered view
+func (h *Harness) View() string {
+	return h.model.View()
+}
+
+// Views returns every view captured so far, one per delivered message
+func (h *Harness) Views() []string {
+	return h.views
+}
+
+// ViewContains reports whether the current view contains the given text
+func (h *Harness) ViewContains(text string) bool {
+	return strings.Contains(h.View(), text)
+}
+
+// Model returns the current model
+func (h *Harness) Model() tea.Model {
+	return h.model
+}
+
+// Quit reports whether the model asked the program to quit
+func (h *Harness) Quit() bool {
+	return h.quit
+}
+
+// process delivers queued messages breadth-first until the queue drains or the step budget runs out
+func (h *Harness) process(queue []tea.Msg) {
+	for steps := 0; len(queue) > 0 && steps < h.MaxSteps && !h.quit; steps++ {
+		msg := queue[0]
+		queue = queue[1:]
+
+		if _, ok := msg.(tea.QuitMsg); ok {
+			h.quit = true
+			return
+		}
+
+		var cmd tea.Cmd
+		h.model, cmd = h.model.Update(msg)
+		h.views = append(h.views, h.model.View())
+		queue = append(queue, h.resolve(cmd)...)
+	}
+}
+
+// resolve runs a command and returns the messages it produced, expanding batches
+func (h *Harness) resolve(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	result := make(chan tea.Msg, 1)
+	go func() {
+		result <- cmd()
+	}()
+
+	var msg tea.Msg
+	select {
+	case msg = <-result:
+	case <-time.After(h.CommandTimeout):
+		return nil
+	}
+
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		var msgs []tea.Msg
+		for _, c := range batch {
+			msgs = append(msgs, h.resolve(c)...)
+		}
+		return msgs
+	}
+	if msg == nil {
+		return nil
+	}
+	return []tea.Msg{msg}
+}
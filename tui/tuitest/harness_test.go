@@ -0,0 +1,67 @@
+package tuitest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type pingMsg struct{}
+type pongMsg struct{}
+
+// counterModel counts the messages it receives and echoes pings as pongs
+type counterModel struct {
+	pings, pongs int
+}
+
+func (m counterModel) Init() tea.Cmd { return nil }
+
+func (m counterModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case pingMsg:
+		m.pings++
+		return m, tea.Batch(
+			func() tea.Msg { return pongMsg{} },
+			tea.Tick(time.Hour, func(time.Time) tea.Msg { return pongMsg{} }),
+		)
+	case pongMsg:
+		m.pongs++
+	case tea.KeyMsg:
+		if msg.String() == "q" {
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m counterModel) View() string {
+	return fmt.Sprintf("pings=%d pongs=%d", m.pings, m.pongs)
+}
+
+func TestHarness_ResolvesBatchesAndDropsTimers(t *testing.T) {
+	h := New(counterModel{})
+
+	h.Send(pingMsg{}, pingMsg{})
+
+	if h.View() != "pings=2 pongs=2" {
+		t.Errorf("Unexpected view: %s", h.View())
+	}
+	if len(h.Views()) != 4 {
+		t.Errorf("Expected a captured view per delivered message, got %d", len(h.Views()))
+	}
+}
+
+func TestHarness_Quit(t *testing.T) {
+	h := New(counterModel{})
+
+	h.Type("q").Send(pingMsg{})
+
+	if !h.Quit() {
+		t.Error("Expected harness to record quit")
+	}
+	if h.ViewContains("pings=1") {
+		t.Error("Expected messages after quit to be ignored")
+	}
+}
@@ -0,0 +1,85 @@
+package variant
+
+import (
+	"404skill-cli/api"
+	"404skill-cli/testreport"
+	"404skill-cli/testrunner"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CachedRunMsg carries the outcome of checking a variant's sources before testing it. Run is the
+// saved run when the sources haven't changed since it, nil when the tests need to run.
+type CachedRunMsg struct {
+	Variant *api.Project
+	Run     *testreport.StoredRun
+}
+
+// ShowCachedRunMsg asks to show a saved run instead of testing the unchanged variant again
+type ShowCachedRunMsg struct {
+	Variant *api.Project
+	Run     *testreport.StoredRun
+}
+
+// sourceHasher returns the runner's source hasher when unchanged runs may be reused, nil otherwise
+func (c *Component) sourceHasher() testrunner.SourceHasher {
+	if c.configManager == nil || c.configManager.GetResultsDir() == "" || !c.configManager.IsCacheUnchangedRunsEnabled() {
+		return nil
+	}
+	hasher, _ := c.testRunner.(testrunner.SourceHasher)
+	return hasher
+}
+
+// checkSourcesCmd looks for a saved run of the variant that tested the sources as they are now
+func (c *Component) checkSourcesCmd(hasher testrunner.SourceHasher, variant *api.Project) tea.Cmd {
+	c.errorMsg = ""
+	c.infoMsg = "Checking for source changes..."
+	resultsDir := c.configManager.GetResultsDir()
+	project := testrunner.Project{ID: variant.ID, Name: variant.Name, Language: variant.Language}
+	return func() tea.Msg {
+		return CachedRunMsg{Variant: variant, Run: testrunner.UnchangedRun(hasher, resultsDir, project)}
+	}
+}
+
+// handleCachedRun offers the saved run of an unchanged variant, or starts its tests otherwise
+func (c *Component) handleCachedRun(msg CachedRunMsg) tea.Cmd {
+	c.infoMsg = ""
+	if msg.Run == nil {
+		return c.beginTest(msg.Variant)
+	}
+	c.cachedRun = &msg
+	return nil
+}
+
+// updateCachedOffer answers the offer of a saved run; every other key is ignored until it is answered
+func (c *Component) updateCachedOffer(msg tea.KeyMsg) tea.Cmd {
+	offer := c.cachedRun
+	switch msg.String() {
+	case "enter", "y":
+		if c.tracer != nil {
+			_ = c.tracer.TrackKeyMsg(msg, "variant_show_cached_run")
+		}
+		c.cachedRun = nil
+		return func() tea.Msg { return ShowCachedRunMsg{Variant: offer.Variant, Run: offer.Run} }
+	case "r":
+		if c.tracer != nil {
+			_ = c.tracer.TrackKeyMsg(msg, "variant_run_despite_cache")
+		}
+		c.cachedRun = nil
+		return c.beginTest(offer.Variant)
+	case "n", "esc":
+		c.cachedRun = nil
+	}
+	return nil
+}
+
+// renderCachedOffer asks whether to show the saved run instead of testing again
+func (c *Component) renderCachedOffer() string {
+	question := lipgloss.NewStyle().Foreground(lipgloss.Color("#00ffaa")).Bold(true).
+		Render(fmt.Sprintf("No source changes since the last run on %s.", c.cachedRun.Run.RanAt.Format("Jan 2 15:04")))
+	hint := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true).
+		Render("[enter] show the saved results • [r] run the tests anyway • [n] cancel")
+	return question + "\n" + hint
+}
@@ -7,7 +7,11 @@ import (
 	"404skill-cli/filesystem"
 	"404skill-cli/testrunner"
 	"404skill-cli/tracing"
+	"404skill-cli/tui/history"
+	"404skill-cli/tui/logviewer"
+	"404skill-cli/tui/styles"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -17,6 +21,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	btable "github.com/evertras/bubble-table/table"
 )
 
@@ -51,6 +56,15 @@ type Component struct {
 	verboseMode      bool
 	highLevelStatus  string
 	filteredMessages []string
+	validation       *testrunner.ValidationReport
+	openOverride     *bool                // open_after_download for the next download only, nil follows the config
+	autoRun          bool                 // the running tests were started by auto_test_after_download
+	rawReport        string               // report of the last run that could not be parsed, empty otherwise
+	cachedRun        *CachedRunMsg        // saved run offered instead of testing an unchanged variant, nil when none is
+	history          *history.Component   // run history of a variant, nil when closed
+	logViewer        *logviewer.Component // raw report of the last run, nil when closed
+	width            int                  // terminal width, 0 until the first resize
+	height           int                  // terminal height, 0 until the first resize
 	tracer           *tracing.TUIIntegration
 }
 
@@ -69,42 +83,17 @@ func NewWithMode(variants []api.Project, downloader downloader.Downloader, testR
 		tuiTracer = tracing.NewTUIIntegration(manager)
 	}
 
-	// Create center alignment style for all columns
-	centerStyle := lipgloss.NewStyle().Align(lipgloss.Center)
-
-	columns := []btable.Column{
-		btable.NewColumn("desc", "Description", 32).WithStyle(centerStyle),
-		btable.NewColumn("tech", "Technologies", 24).WithStyle(centerStyle),
-		btable.NewColumn("diff", "Difficulty", 12).WithStyle(centerStyle),
-		btable.NewColumn("downloaded", "Downloaded", 12).WithStyle(centerStyle),
-	}
-	var rows []btable.Row
-	for _, v := range variants {
-		downloadedStatus := "✗"
-		if configManager != nil && configManager.IsProjectDownloaded(v.ID) {
-			downloadedStatus = "✓"
-		}
-
-		rows = append(rows, btable.NewRow(map[string]interface{}{
-			"desc":       v.Description,
-			"tech":       v.Technologies,
-			"diff":       v.Difficulty,
-			"downloaded": downloadedStatus,
-		}))
-	}
-	table := btable.New(columns).WithRows(rows).Focused(true)
-
 	component := &Component{
 		variants:      variants,
 		configManager: configManager,
 		fileManager:   fileManager,
 		downloader:    downloader,
 		testRunner:    testRunner,
-		table:         table,
 		selectedIdx:   0,
 		mode:          mode,
 		tracer:        tuiTracer,
 	}
+	component.refreshTable()
 
 	// Track component initialization
 	if tuiTracer != nil {
@@ -153,6 +142,7 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 			}
 			c.downloading = false
 			c.selectedVariant = msg.Variant
+			c.infoMsg = msg.Warning
 			c.refreshTable()
 			return c, nil
 		case DownloadErrorMsg:
@@ -181,6 +171,10 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 			}
 			c.testing = false
 			c.errorMsg = msg.Error
+			c.rawReport = msg.ReportPath
+			if c.rawReport != "" {
+				c.infoMsg = "[v] open the raw report • [enter] retry the run"
+			}
 			return c, nil
 		case spinnerMsg:
 			c.spinnerFrame = msg.frame
@@ -203,6 +197,40 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 		return c, c.spinnerTick()
 	}
 
+	if msg, ok := msg.(EditorClosedMsg); ok {
+		if msg.Error != nil {
+			c.errorMsg = fmt.Sprintf("Editor exited with an error: %v", msg.Error)
+		}
+		return c, nil
+	}
+
+	if msg, ok := msg.(ValidationCompleteMsg); ok {
+		c.infoMsg = ""
+		c.validation = msg.Report
+		return c, nil
+	}
+
+	if c.history != nil {
+		return c, c.updateHistory(msg)
+	}
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		c.height = size.Height
+	}
+	if c.logViewer != nil {
+		return c, c.updateLogViewer(msg)
+	}
+
+	if msg, ok := msg.(CachedRunMsg); ok {
+		return c, c.handleCachedRun(msg)
+	}
+	if m, ok := msg.(tea.KeyMsg); ok && c.cachedRun != nil {
+		return c, c.updateCachedOffer(m)
+	}
+
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		c.SetWidth(size.Width)
+	}
+
 	c.table, _ = c.table.Update(msg)
 
 	if m, ok := msg.(tea.KeyMsg); ok {
@@ -233,6 +261,73 @@ func (c *Component) Update(msg tea.Msg) (*Component, tea.Cmd) {
 					return c.handleTestAction(&variant)
 				}
 			}
+		case "c":
+			if c.mode == TestMode && c.selectedIdx >= 0 && c.selectedIdx < len(c.variants) {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_validate")
+				}
+				variant := c.variants[c.selectedIdx]
+				return c.handleValidateAction(&variant)
+			}
+		case "D":
+			if c.tracer != nil {
+				_ = c.tracer.TrackKeyMsg(m, "variant_difficulty_colors_toggle")
+			}
+			styles.ToggleDifficultyColors()
+			c.refreshTable()
+		case "o":
+			if c.mode == DownloadMode {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_open_after_download_toggle")
+				}
+				c.toggleOpenAfterDownload()
+			}
+		case "f":
+			if fast, ok := c.testRunner.(testrunner.FastRerunner); ok && c.mode == TestMode {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_fast_rerun_toggle")
+				}
+				fast.SetFastRerun(!fast.FastRerun())
+			}
+		case "e":
+			if c.selectedIdx >= 0 && c.selectedIdx < len(c.variants) {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_open_editor")
+				}
+				variant := c.variants[c.selectedIdx]
+				return c.handleEditorAction(&variant)
+			}
+		case "v":
+			if c.rawReport != "" {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_open_raw_report")
+				}
+				return c.handleRawReportAction()
+			}
+		case "w":
+			if c.selectedIdx >= 0 && c.selectedIdx < len(c.variants) {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_open_page")
+				}
+				variant := c.variants[c.selectedIdx]
+				return c.handleOpenPageAction(&variant)
+			}
+		case "y":
+			if c.selectedIdx >= 0 && c.selectedIdx < len(c.variants) {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_copy_test_command")
+				}
+				variant := c.variants[c.selectedIdx]
+				return c.handleCopySnippetAction(&variant)
+			}
+		case "h":
+			if c.mode == TestMode && c.selectedIdx >= 0 && c.selectedIdx < len(c.variants) {
+				if c.tracer != nil {
+					_ = c.tracer.TrackKeyMsg(m, "variant_history")
+				}
+				variant := c.variants[c.selectedIdx]
+				c.openHistory(&variant)
+			}
 		case "esc", "b":
 			if c.tracer != nil {
 				_ = c.tracer.TrackKeyMsg(m, "variant_back_navigation")
@@ -259,29 +354,12 @@ func (c *Component) handleDownloadAction(variant *api.Project) (*Component, tea.
 			_ = c.tracer.TrackProjectOperation("project_already_downloaded", variant.Name)
 		}
 
-		if c.fileManager != nil {
-			homeDir, err := os.UserHomeDir()
-			if err == nil {
-				repoName := strings.ToLower(strings.ReplaceAll(variant.Name, " ", "_"))
-				projectsDir := filepath.Join(homeDir, "404skill_projects")
-				entries, err := os.ReadDir(projectsDir)
-				if err == nil {
-					var projectDir string
-					for _, entry := range entries {
-						if entry.IsDir() && strings.HasPrefix(entry.Name(), repoName) {
-							projectDir = filepath.Join(projectsDir, entry.Name())
-							break
-						}
-					}
-					if projectDir != "" {
-						if c.tracer != nil {
-							fileTracker := c.tracer.TrackFileOperation("open_project_directory", projectDir)
-							_ = fileTracker.Complete()
-						}
-						_ = c.fileManager.OpenFileExplorer(projectDir)
-					}
-				}
+		if projectDir := c.projectDirectory(variant); c.fileManager != nil && projectDir != "" {
+			if c.tracer != nil {
+				fileTracker := c.tracer.TrackFileOperation("open_project_directory", projectDir)
+				_ = fileTracker.Complete()
 			}
+			_ = c.fileManager.OpenFileExplorer(projectDir)
 		}
 		c.infoMsg = "Project already downloaded. Opening project directory..."
 		return c, nil
@@ -295,6 +373,109 @@ func (c *Component) handleDownloadAction(variant *api.Project) (*Component, tea.
 	return c, c.downloadWithProgress(variant)
 }
 
+// projectDirectory finds the downloaded directory for a variant, or "" if it is not on disk
+func (c *Component) projectDirectory(variant *api.Project) string {
+	if c.configManager == nil {
+		return ""
+	}
+	projectsDir, err := c.configManager.GetProjectsDir()
+	if err != nil {
+		return ""
+	}
+	// Variants share a name, so only the "<repo>_<id>" directory the download used is this one
+	repoName := strings.ToLower(strings.ReplaceAll(variant.Name, " ", "_"))
+	projectDir := filepath.Join(projectsDir, fmt.Sprintf("%s_%s", repoName, variant.ID))
+	if info, err := os.Stat(projectDir); err != nil || !info.IsDir() {
+		return ""
+	}
+	return projectDir
+}
+
+// handleEditorAction opens a downloaded variant in the user's editor
+func (c *Component) handleEditorAction(variant *api.Project) (*Component, tea.Cmd) {
+	c.errorMsg = ""
+	if c.configManager == nil || !c.configManager.IsProjectDownloaded(variant.ID) {
+		c.infoMsg = "Download the project before opening it in an editor."
+		return c, nil
+	}
+	projectDir := c.projectDirectory(variant)
+	if projectDir == "" || c.fileManager == nil {
+		c.errorMsg = "Project directory not found. Try downloading the project again."
+		return c, nil
+	}
+
+	if c.tracer != nil {
+		fileTracker := c.tracer.TrackFileOperation("open_project_in_editor", projectDir)
+		_ = fileTracker.Complete()
+	}
+	// A terminal editor takes over the screen until it exits
+	if cmd := c.fileManager.EditorCommand(projectDir); cmd != nil && filesystem.IsTerminalEditor(cmd) {
+		c.infoMsg = ""
+		return c, tea.ExecProcess(cmd, func(err error) tea.Msg { return EditorClosedMsg{Error: err} })
+	}
+	if err := c.fileManager.OpenInEditor(projectDir); err != nil {
+		c.errorMsg = fmt.Sprintf("Failed to open editor: %v", err)
+		return c, nil
+	}
+	c.infoMsg = "Opening project in your editor..."
+	return c, nil
+}
+
+// handleRawReportAction opens the report the last run could not parse in the inline viewer
+func (c *Component) handleRawReportAction() (*Component, tea.Cmd) {
+	c.openRawReport()
+	return c, nil
+}
+
+// handleOpenPageAction opens the variant's web page with its instructions in the browser
+func (c *Component) handleOpenPageAction(variant *api.Project) (*Component, tea.Cmd) {
+	c.errorMsg = ""
+	page := variant.WebPage()
+	if page == "" {
+		c.infoMsg = "This project has no web page."
+		return c, nil
+	}
+	if c.fileManager == nil {
+		c.infoMsg = "Project page: " + page
+		return c, nil
+	}
+	if err := c.fileManager.OpenURL(page); err != nil {
+		c.errorMsg = fmt.Sprintf("Failed to open browser: %v", err)
+		return c, nil
+	}
+	c.infoMsg = "Opening " + page + " in your browser..."
+	return c, nil
+}
+
+// handleCopySnippetAction copies a line that runs a downloaded variant's tests from any terminal
+func (c *Component) handleCopySnippetAction(variant *api.Project) (*Component, tea.Cmd) {
+	c.errorMsg = ""
+	if c.configManager == nil || !c.configManager.IsProjectDownloaded(variant.ID) {
+		c.infoMsg = "Download the project before copying its test command."
+		return c, nil
+	}
+	projectDir := c.projectDirectory(variant)
+	if projectDir == "" {
+		c.errorMsg = "Project directory not found. Try downloading the project again."
+		return c, nil
+	}
+	snippet := testrunner.TestSnippet(projectDir, variant.Language, c.configManager.GetTestService(variant.ID))
+	if snippet == "" {
+		c.infoMsg = fmt.Sprintf("No test command is known for %s projects.", variant.Language)
+		return c, nil
+	}
+	if c.fileManager == nil {
+		c.infoMsg = "Test command: " + snippet
+		return c, nil
+	}
+	if err := c.fileManager.CopyToClipboard(snippet); err != nil {
+		c.errorMsg = fmt.Sprintf("Failed to copy to clipboard: %v", err)
+		return c, nil
+	}
+	c.infoMsg = "Copied: " + snippet
+	return c, nil
+}
+
 func (c *Component) handleTestAction(variant *api.Project) (*Component, tea.Cmd) {
 	// Track test action initiation
 	if c.tracer != nil {
@@ -310,9 +491,21 @@ func (c *Component) handleTestAction(variant *api.Project) (*Component, tea.Cmd)
 		return c, nil
 	}
 
-	// Only here, Docker is running, so start the test
+	if hasher := c.sourceHasher(); hasher != nil {
+		return c, c.checkSourcesCmd(hasher, variant)
+	}
+	return c, c.beginTest(variant)
+}
+
+// beginTest starts running a variant's tests
+func (c *Component) beginTest(variant *api.Project) tea.Cmd {
 	c.testing = true
+	c.autoRun = false
+	c.rawReport = ""
 	c.verboseMode = false // Start in simple mode
+	if debug, ok := c.testRunner.(testrunner.DebugReporter); ok && debug.DebugTests() {
+		c.verboseMode = true // Debug runs show all container output
+	}
 	c.currentOperation = "Initializing tests..."
 	c.highLevelStatus = "Preparing to run tests..."
 	c.spinnerFrame = spinnerFrames[0]
@@ -320,19 +513,81 @@ func (c *Component) handleTestAction(variant *api.Project) (*Component, tea.Cmd)
 	c.filteredMessages = []string{} // Clear previous filtered messages
 	c.errorMsg = ""                 // Clear previous errors
 	c.infoMsg = ""                  // Clear previous info
-	return c, tea.Batch(
+	return tea.Batch(
 		c.startTest(variant),
 		c.spinnerTick(),
 	)
 }
 
+// StartAutoTest runs the tests of a variant that has just been downloaded, showing that the
+// run was started automatically
+func (c *Component) StartAutoTest(variant *api.Project) tea.Cmd {
+	if c.tracer != nil {
+		_ = c.tracer.TrackProjectOperation("auto_test_after_download", variant.Name)
+	}
+	if c.configManager == nil || !c.configManager.IsProjectDownloaded(variant.ID) {
+		c.errorMsg = "Project must be downloaded before testing. Please download it first."
+		return nil
+	}
+	// The download has just replaced the sources, so there is no point checking for a saved run
+	cmd := c.beginTest(variant)
+	if c.testing {
+		c.autoRun = true
+		c.highLevelStatus = "Download complete, running tests automatically..."
+	}
+	return cmd
+}
+
+// handleValidateAction checks the test prerequisites for a variant without running its tests
+func (c *Component) handleValidateAction(variant *api.Project) (*Component, tea.Cmd) {
+	validator, ok := c.testRunner.(testrunner.Validator)
+	if !ok {
+		c.errorMsg = "Setup checks are not supported by this test runner."
+		return c, nil
+	}
+
+	c.errorMsg = ""
+	c.validation = nil
+	c.infoMsg = "Checking setup..."
+
+	project := testrunner.Project{
+		ID:       variant.ID,
+		Name:     variant.Name,
+		Language: variant.Language,
+	}
+	return c, func() tea.Msg {
+		report := validator.Validate(project)
+		if c.tracer != nil && !report.OK() {
+			_ = c.tracer.TrackError(fmt.Errorf("%d setup checks failed", len(report.Failed())), "variant", "validate")
+		}
+		return ValidationCompleteMsg{Report: report}
+	}
+}
+
 func (c *Component) downloadWithProgress(variant *api.Project) tea.Cmd {
+	// The override applies to this download only
+	override := c.openOverride
+	c.openOverride = nil
 	return tea.Batch(
-		c.startDownload(variant),
+		c.startDownload(variant, override),
 		c.progressTicker(),
 	)
 }
 
+// opensAfterDownload reports whether the next download will open the file explorer
+func (c *Component) opensAfterDownload() bool {
+	if c.openOverride != nil {
+		return *c.openOverride
+	}
+	return c.configManager == nil || c.configManager.IsOpenAfterDownloadEnabled()
+}
+
+// toggleOpenAfterDownload flips whether the next download opens the file explorer
+func (c *Component) toggleOpenAfterDownload() {
+	open := !c.opensAfterDownload()
+	c.openOverride = &open
+}
+
 func (c *Component) testWithProgress(variant *api.Project) tea.Cmd {
 	return tea.Batch(
 		c.startTest(variant),
@@ -340,7 +595,7 @@ func (c *Component) testWithProgress(variant *api.Project) tea.Cmd {
 	)
 }
 
-func (c *Component) startDownload(variant *api.Project) tea.Cmd {
+func (c *Component) startDownload(variant *api.Project, openOverride *bool) tea.Cmd {
 	return func() tea.Msg {
 		// Track download operation
 		var downloadTracker *tracing.TimedOperationTracker
@@ -352,12 +607,39 @@ func (c *Component) startDownload(variant *api.Project) tea.Cmd {
 		}
 
 		ctx := context.Background()
+		if openOverride != nil {
+			ctx = downloader.WithOpenAfterDownload(ctx, *openOverride)
+		}
 		progressCallback := func(progress float64) {
 			atomic.StoreUint64(&c.atomicProgress, uint64(progress*100))
 		}
 		c.SetDownloading(true)
 		c.currentOperation = "Cloning project..."
-		err := c.downloader.DownloadProject(ctx, variant, variant.Language, progressCallback)
+		var err error
+		if streaming, ok := c.downloader.(downloader.OutputDownloader); ok {
+			// Post-download hook output replaces the operation line as it streams in
+			outputCallback := func(line string) {
+				c.currentOperation = line
+			}
+			err = streaming.DownloadProjectWithOutput(ctx, variant, variant.Language, progressCallback, outputCallback)
+		} else {
+			err = c.downloader.DownloadProject(ctx, variant, variant.Language, progressCallback)
+		}
+
+		if downloader.IsWarning(err) {
+			if downloadTracker != nil {
+				var pending *downloader.InitPendingError
+				if errors.As(err, &pending) {
+					downloadTracker.AddMetadata("init_pending", "true")
+				}
+				var hook *downloader.HookError
+				if errors.As(err, &hook) {
+					downloadTracker.AddMetadata("hook_failed", "true")
+				}
+				_ = downloadTracker.Complete()
+			}
+			return DownloadCompleteMsg{Variant: variant, Warning: err.Error()}
+		}
 
 		if err != nil {
 			if downloadTracker != nil {
@@ -375,6 +657,7 @@ func (c *Component) startDownload(variant *api.Project) tea.Cmd {
 }
 
 func (c *Component) startTest(variant *api.Project) tea.Cmd {
+	hasher := c.sourceHasher()
 	return func() tea.Msg {
 		// Track test operation
 		var testTracker *tracing.TimedOperationTracker
@@ -397,20 +680,31 @@ func (c *Component) startTest(variant *api.Project) tea.Cmd {
 			c.processProgressMessage(message)
 		}
 
+		// Hash before running, so edits made during the run count as changes next time
+		var sourceHash string
+		if hasher != nil {
+			sourceHash, _ = hasher.SourceHash(testProject)
+		}
+
 		// Run tests
 		result, err := c.testRunner.RunTests(testProject, progressCallback)
 		if err != nil {
 			if testTracker != nil {
 				_ = testTracker.CompleteWithError(err)
 			}
-			return TestErrorMsg{Error: err.Error()}
+			msg := TestErrorMsg{Error: err.Error()}
+			var parseErr *testrunner.ReportParseError
+			if errors.As(err, &parseErr) {
+				msg.ReportPath = parseErr.Path
+			}
+			return msg
 		}
 
 		if testTracker != nil {
 			_ = testTracker.Complete()
 		}
 
-		return TestCompleteMsg{Variant: variant, Result: result}
+		return TestCompleteMsg{Variant: variant, Result: result, SourceHash: sourceHash}
 	}
 }
 
@@ -517,11 +811,28 @@ func (c *Component) View() string {
 		return c.renderTestingSpinner()
 	}
 
+	if c.history != nil {
+		return c.history.View()
+	}
+
+	if c.logViewer != nil {
+		return c.logViewer.View()
+	}
+
 	view := c.renderHeader()
 	view += "\n\n" + c.renderTable()
+	if detail := c.renderDescriptionDetail(); detail != "" {
+		view += "\n" + detail
+	}
 	if c.infoMsg != "" {
 		view += "\n\n" + c.renderInfo()
 	}
+	if c.validation != nil {
+		view += "\n\n" + c.renderValidation()
+	}
+	if c.cachedRun != nil {
+		view += "\n\n" + c.renderCachedOffer()
+	}
 	if c.errorMsg != "" {
 		view += "\n\n" + c.renderError()
 	}
@@ -542,9 +853,38 @@ func (c *Component) renderHeader() string {
 		headerText = "Select a variant to test:"
 	}
 
+	if fast, ok := c.testRunner.(testrunner.FastRerunner); ok && c.mode == TestMode && fast.FastRerun() {
+		modeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true)
+		return style.Render(headerText) + " " + modeStyle.Render("(fast rerun: reusing existing image)")
+	}
+
+	if c.mode == DownloadMode {
+		modeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true)
+		note := "(opens the folder when done)"
+		if !c.opensAfterDownload() {
+			note = "(won't open the folder when done)"
+		}
+		if c.openOverride != nil {
+			note = strings.TrimSuffix(note, ")") + ", next download only)"
+		}
+		return style.Render(headerText) + " " + modeStyle.Render(note)
+	}
+
 	return style.Render(headerText)
 }
 
+// renderDescriptionDetail shows the full description of the highlighted variant, which the table may cut short
+func (c *Component) renderDescriptionDetail() string {
+	if c.selectedIdx < 0 || c.selectedIdx >= len(c.variants) || c.variants[c.selectedIdx].Description == "" {
+		return ""
+	}
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).Italic(true)
+	if c.width > 0 {
+		style = style.Width(c.width)
+	}
+	return style.Render(c.variants[c.selectedIdx].Description)
+}
+
 func (c *Component) renderTable() string {
 	return c.table.WithHighlightedRow(c.selectedIdx).View()
 }
@@ -577,7 +917,11 @@ func (c *Component) renderTestingSpinner() string {
 		Italic(true)
 
 	// Header with spinner
-	header := style.Render("Testing Project") + "\n" +
+	title := "Testing Project"
+	if c.autoRun {
+		title += " (auto-run after download)"
+	}
+	header := style.Render(title) + "\n" +
 		spinnerStyle.Render(c.spinnerFrame) + " " + style.Render(c.highLevelStatus)
 
 	// Mode indicator and instructions
@@ -620,6 +964,27 @@ func (c *Component) renderInfo() string {
 	return style.Render(c.infoMsg)
 }
 
+func (c *Component) renderValidation() string {
+	passStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00ffaa"))
+	failStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000"))
+	detailStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+
+	lines := []string{}
+	for _, check := range c.validation.Checks {
+		mark := passStyle.Render("✓")
+		if !check.Passed {
+			mark = failStyle.Render("✗")
+		}
+		lines = append(lines, fmt.Sprintf("%s %s  %s", mark, check.Name, detailStyle.Render(check.Detail)))
+	}
+
+	summary := passStyle.Bold(true).Render("Ready to test")
+	if !c.validation.OK() {
+		summary = failStyle.Bold(true).Render(fmt.Sprintf("%d of %d checks failed", len(c.validation.Failed()), len(c.validation.Checks)))
+	}
+	return summary + "\n" + strings.Join(lines, "\n")
+}
+
 func (c *Component) renderError() string {
 	style := lipgloss.NewStyle().
 		Foreground(lipgloss.Color("#ff0000")).
@@ -628,13 +993,22 @@ func (c *Component) renderError() string {
 }
 
 type DownloadProgressMsg struct{ Progress float64 }
-type DownloadCompleteMsg struct{ Variant *api.Project }
+type DownloadCompleteMsg struct {
+	Variant *api.Project
+	Warning string // set when the download succeeded but a follow-up step did not
+}
 type DownloadErrorMsg struct{ Error string }
 type TestCompleteMsg struct {
-	Variant *api.Project
-	Result  interface{} // Will be the test result from testrunner
+	Variant    *api.Project
+	Result     interface{} // Will be the test result from testrunner
+	SourceHash string      // hash of the sources the run tested, empty when not computed
+}
+type TestErrorMsg struct {
+	Error      string
+	ReportPath string // set when the run wrote a report that could not be parsed
 }
-type TestErrorMsg struct{ Error string }
+type ValidationCompleteMsg struct{ Report *testrunner.ValidationReport }
+type EditorClosedMsg struct{ Error error } // a terminal editor exited
 type BackMsg struct{}
 type QuitMsg struct{}
 
@@ -678,15 +1052,47 @@ func (c *Component) IsDownloading() bool {
 	return c.downloading
 }
 
+// Column widths of the variant table. Description and Technologies share whatever the terminal
+// leaves after the fixed columns; before the first resize they use their default widths.
+const (
+	langColumnWidth       = 8
+	diffColumnWidth       = 12
+	downloadedColumnWidth = 12
+	defaultDescWidth      = 32
+	defaultTechWidth      = 24
+	minDescWidth          = 16
+	minTechWidth          = 12
+	tableBorderWidth      = 6 // one border per column plus the outer edge
+)
+
+// SetWidth lays the table out for the terminal width
+func (c *Component) SetWidth(width int) {
+	c.width = width
+	c.refreshTable()
+}
+
+// flexibleColumnWidths splits the width left by the fixed columns between Description and Technologies
+func (c *Component) flexibleColumnWidths() (desc, tech int) {
+	if c.width <= 0 {
+		return defaultDescWidth, defaultTechWidth
+	}
+	available := c.width - tableBorderWidth - langColumnWidth - diffColumnWidth - downloadedColumnWidth
+	desc = available * 3 / 5
+	tech = available - desc
+	return max(desc, minDescWidth), max(tech, minTechWidth)
+}
+
 func (c *Component) refreshTable() {
 	// Create center alignment style for all columns
 	centerStyle := lipgloss.NewStyle().Align(lipgloss.Center)
+	descWidth, techWidth := c.flexibleColumnWidths()
 
 	columns := []btable.Column{
-		btable.NewColumn("desc", "Description", 32).WithStyle(centerStyle),
-		btable.NewColumn("tech", "Technologies", 24).WithStyle(centerStyle),
-		btable.NewColumn("diff", "Difficulty", 12).WithStyle(centerStyle),
-		btable.NewColumn("downloaded", "Downloaded", 12).WithStyle(centerStyle),
+		btable.NewColumn("lang", "Lang", langColumnWidth).WithStyle(centerStyle),
+		btable.NewColumn("desc", "Description", descWidth).WithStyle(centerStyle),
+		btable.NewColumn("tech", "Technologies", techWidth).WithStyle(centerStyle),
+		btable.NewColumn("diff", "Difficulty", diffColumnWidth).WithStyle(centerStyle),
+		btable.NewColumn("downloaded", "Downloaded", downloadedColumnWidth).WithStyle(centerStyle),
 	}
 	var rows []btable.Row
 	for _, v := range c.variants {
@@ -696,11 +1102,17 @@ func (c *Component) refreshTable() {
 		}
 
 		rows = append(rows, btable.NewRow(map[string]interface{}{
-			"desc":       v.Description,
-			"tech":       v.Technologies,
-			"diff":       v.Difficulty,
+			"lang":       styles.LanguageCell(v.Language),
+			"desc":       truncateCell(v.Description, descWidth),
+			"tech":       truncateCell(v.Technologies, techWidth),
+			"diff":       styles.DifficultyCell(v.Difficulty),
 			"downloaded": downloadedStatus,
 		}))
 	}
 	c.table = btable.New(columns).WithRows(rows).Focused(true)
 }
+
+// truncateCell shortens text to fit a column, marking the cut with an ellipsis
+func truncateCell(text string, width int) string {
+	return ansi.Truncate(text, width, "…")
+}
@@ -0,0 +1,380 @@
+package variant
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"404skill-cli/api"
+	"404skill-cli/config"
+	"404skill-cli/filesystem"
+	"404skill-cli/testreport"
+	"404skill-cli/testrunner"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestComponent_LongDescription_TruncatedInRowAndShownInDetail(t *testing.T) {
+	// Arrange
+	long := "Build a journal REST API with pagination, full text search and tagging"
+	variants := []api.Project{
+		{ID: "1", Name: "Journal API", Language: "go", Difficulty: "easy", Description: "Short one"},
+		{ID: "2", Name: "Journal API", Language: "python", Difficulty: "easy", Description: long},
+	}
+	c := New(variants, nil, nil, nil)
+	c.SetWidth(100)
+	descWidth, _ := c.flexibleColumnWidths()
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyDown})
+	view := c.View()
+
+	// Assert
+	cell, ok := c.table.GetVisibleRows()[1].Data["desc"].(string)
+	if !ok {
+		t.Fatalf("Expected a string description cell, got %T", c.table.GetVisibleRows()[1].Data["desc"])
+	}
+	if cell == long || !strings.HasSuffix(cell, "…") {
+		t.Errorf("Expected the description to be cut with an ellipsis, got %q", cell)
+	}
+	if width := ansi.StringWidth(cell); width > descWidth {
+		t.Errorf("Expected the cell to fit in %d columns, got %d", descWidth, width)
+	}
+	if !strings.Contains(view, long) {
+		t.Errorf("Expected the full description below the table, got:\n%s", view)
+	}
+}
+
+func TestComponent_SetWidth_GrowsDescriptionColumn(t *testing.T) {
+	c := New([]api.Project{{ID: "1", Description: "desc"}}, nil, nil, nil)
+
+	defaultWidth, _ := c.flexibleColumnWidths()
+	c.SetWidth(160)
+	wideWidth, _ := c.flexibleColumnWidths()
+	c.SetWidth(40)
+	narrowWidth, _ := c.flexibleColumnWidths()
+
+	if defaultWidth != defaultDescWidth {
+		t.Errorf("Expected default width %d before a resize, got %d", defaultDescWidth, defaultWidth)
+	}
+	if wideWidth <= defaultDescWidth {
+		t.Errorf("Expected a wide terminal to widen the description column, got %d", wideWidth)
+	}
+	if narrowWidth != minDescWidth {
+		t.Errorf("Expected a narrow terminal to use the minimum width %d, got %d", minDescWidth, narrowWidth)
+	}
+}
+
+func TestComponent_OpenPage_WithoutPage(t *testing.T) {
+	// Arrange
+	c := New([]api.Project{{ID: "1", Name: "Journal API", RepoUrl: "git@github.com:404skill/journal.git"}}, nil, nil, nil)
+
+	// Act
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+
+	// Assert
+	if cmd != nil {
+		t.Error("Expected no command when the project has no page")
+	}
+	if !strings.Contains(c.View(), "no web page") {
+		t.Errorf("Expected a missing page notice, got:\n%s", c.View())
+	}
+}
+
+func TestComponent_OpenPage_ShowsAddressWithoutBrowser(t *testing.T) {
+	// Arrange
+	c := New([]api.Project{{ID: "1", Name: "Journal API", PageURL: "https://404skill.dev/p/journal"}}, nil, nil, nil)
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+
+	// Assert
+	if !strings.Contains(c.View(), "https://404skill.dev/p/journal") {
+		t.Errorf("Expected the page address in view, got:\n%s", c.View())
+	}
+}
+
+func TestComponent_CopySnippet_ShowsDirectoryAndCommand(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	projectsDir := filepath.Join(dir, "projects")
+	projectDir := filepath.Join(projectsDir, "journal_api_p1")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatalf("Failed to create project dir: %v", err)
+	}
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(dir, "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	settings := "projects_dir: " + projectsDir + "\ndownloaded_projects:\n  p1: true\n"
+	if err := os.WriteFile(config.ConfigFilePath, []byte(settings), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	c := New([]api.Project{{ID: "p1", Name: "Journal API", Language: "go"}}, nil, config.NewConfigManager(nil), nil)
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	// Assert
+	want := "cd " + projectDir + " && go test ./..."
+	if !strings.Contains(ansi.Strip(c.View()), want) {
+		t.Errorf("Expected the snippet %q in view, got:\n%s", want, c.View())
+	}
+}
+
+func TestComponent_CopySnippet_RequiresDownload(t *testing.T) {
+	// Arrange
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	if err := os.WriteFile(config.ConfigFilePath, []byte("{}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	c := New([]api.Project{{ID: "p1", Name: "Journal API", Language: "go"}}, nil, config.NewConfigManager(nil), nil)
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	// Assert
+	if !strings.Contains(c.View(), "Download the project before copying") {
+		t.Errorf("Expected a download notice, got:\n%s", c.View())
+	}
+}
+
+func TestComponent_CopySnippet_UsesSelectedVariantDirectory(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	projectsDir := filepath.Join(dir, "projects")
+	for _, name := range []string{"journal_api_p1", "journal_api_p2"} {
+		if err := os.MkdirAll(filepath.Join(projectsDir, name), 0755); err != nil {
+			t.Fatalf("Failed to create project dir: %v", err)
+		}
+	}
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(dir, "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	settings := "projects_dir: " + projectsDir + "\ndownloaded_projects:\n  p1: true\n  p2: true\n"
+	if err := os.WriteFile(config.ConfigFilePath, []byte(settings), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	variants := []api.Project{
+		{ID: "p1", Name: "Journal API", Language: "go"},
+		{ID: "p2", Name: "Journal API", Language: "python"},
+	}
+	c := New(variants, nil, config.NewConfigManager(nil), nil)
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+
+	// Assert
+	want := "cd " + filepath.Join(projectsDir, "journal_api_p2") + " && pytest"
+	if !strings.Contains(ansi.Strip(c.View()), want) {
+		t.Errorf("Expected the python variant's snippet %q in view, got:\n%s", want, c.View())
+	}
+}
+
+func TestComponent_OpenEditor_RunsTerminalEditorInForeground(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	projectsDir := filepath.Join(dir, "projects")
+	if err := os.MkdirAll(filepath.Join(projectsDir, "journal_api_p1"), 0755); err != nil {
+		t.Fatalf("Failed to create project dir: %v", err)
+	}
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(dir, "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	settings := "projects_dir: " + projectsDir + "\ndownloaded_projects:\n  p1: true\n"
+	if err := os.WriteFile(config.ConfigFilePath, []byte(settings), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	fileManager := filesystem.NewManager()
+	fileManager.SetEditor("vim")
+	c := New([]api.Project{{ID: "p1", Name: "Journal API", Language: "go"}}, nil, config.NewConfigManager(nil), fileManager)
+
+	// Act
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected the terminal editor to be run in the foreground")
+	}
+	if c.errorMsg != "" {
+		t.Errorf("Expected no error, got %q", c.errorMsg)
+	}
+
+	// An editor that fails is reported once it exits
+	c, _ = c.Update(EditorClosedMsg{Error: errors.New("exit status 1")})
+	if !strings.Contains(c.View(), "Editor exited with an error") {
+		t.Errorf("Expected the editor error in view, got:\n%s", c.View())
+	}
+}
+
+// stubRunner runs no tests and reports an empty suite
+type stubRunner struct{}
+
+func (stubRunner) RunTests(testrunner.Project, func(string)) (*testreport.ParseResult, error) {
+	return &testreport.ParseResult{}, nil
+}
+
+// useDownloadedProject points the config at a temp file recording p1 as downloaded
+func useDownloadedProject(t *testing.T, settings string) {
+	t.Helper()
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	if err := os.WriteFile(config.ConfigFilePath, []byte("downloaded_projects:\n  p1: true\n"+settings), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+}
+
+func TestComponent_UnparsableReport_OpensInViewerAndRetries(t *testing.T) {
+	// Arrange - the run wrote a report that could not be parsed
+	useDownloadedProject(t, "")
+	reportPath := filepath.Join(t.TempDir(), "report.xml")
+	if err := os.WriteFile(reportPath, []byte("<testsuite name=\"broken\""), 0644); err != nil {
+		t.Fatalf("Failed to write report: %v", err)
+	}
+	c := NewForTesting([]api.Project{{ID: "p1", Name: "Journal API", Language: "go"}}, stubRunner{}, config.NewConfigManager(nil), nil)
+	c.SetTesting(true)
+	c, _ = c.Update(TestErrorMsg{Error: "failed to parse test report", ReportPath: reportPath})
+	if !strings.Contains(c.View(), "[v] open the raw report") {
+		t.Fatalf("Expected the raw report prompt, got:\n%s", c.View())
+	}
+
+	// Act
+	c, _ = c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+
+	// Assert - the report is shown in the app, not handed to an editor
+	if !c.IsViewingLog() || !strings.Contains(c.View(), `<testsuite name="broken"`) {
+		t.Fatalf("Expected the raw report in the viewer, got:\n%s", c.View())
+	}
+
+	// Closing the viewer returns to the prompt, where enter runs the tests again
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	c, _ = c.Update(cmd())
+	if c.IsViewingLog() {
+		t.Fatal("Expected esc to close the viewer")
+	}
+	c, cmd = c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil || !c.IsTesting() {
+		t.Error("Expected enter to retry the run")
+	}
+}
+
+// hashingRunner reports a fixed source hash, as if the sources were hashed
+type hashingRunner struct {
+	stubRunner
+	hash string
+}
+
+func (r hashingRunner) SourceHash(testrunner.Project) (string, error) {
+	return r.hash, nil
+}
+
+// newCachingComponent returns a test-mode component for p1 whose last run tested sources with hash "abc"
+func newCachingComponent(t *testing.T, currentHash string) *Component {
+	t.Helper()
+	useDownloadedProject(t, "cache_unchanged_runs: true\n")
+	configManager := config.NewConfigManager(nil)
+	result := &testreport.ParseResult{Suite: testreport.TestSuite{Name: "Suite"}, PassedTests: []string{"test_one"}}
+	run := testreport.StoredRun{ProjectID: "p1", RanAt: time.Now(), Result: result, SourceHash: "abc"}
+	if err := testreport.SaveLastRun(configManager.GetResultsDir(), run); err != nil {
+		t.Fatalf("Failed to save run: %v", err)
+	}
+	return NewForTesting([]api.Project{{ID: "p1", Name: "Journal API", Language: "go"}}, hashingRunner{hash: currentHash}, configManager, nil)
+}
+
+// checkSources presses enter and feeds back the result of checking the sources
+func checkSources(t *testing.T, c *Component) (*Component, tea.Cmd) {
+	t.Helper()
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected enter to check the sources")
+	}
+	msg, ok := cmd().(CachedRunMsg)
+	if !ok {
+		t.Fatalf("Expected a CachedRunMsg, got %T", msg)
+	}
+	return c.Update(msg)
+}
+
+func TestComponent_UnchangedSources_OfferSavedRun(t *testing.T) {
+	// Arrange
+	c := newCachingComponent(t, "abc")
+	c, _ = checkSources(t, c)
+	if c.IsTesting() || !strings.Contains(c.View(), "No source changes since the last run") {
+		t.Fatalf("Expected the saved run to be offered, got:\n%s", c.View())
+	}
+
+	// Act
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("Expected enter to show the saved run")
+	}
+	msg, ok := cmd().(ShowCachedRunMsg)
+	if !ok || msg.Run == nil || msg.Run.SourceHash != "abc" {
+		t.Errorf("Expected the saved run to be shown, got %+v", msg)
+	}
+	if c.IsTesting() {
+		t.Error("Expected no test run")
+	}
+}
+
+func TestComponent_UnchangedSources_RunAnyway(t *testing.T) {
+	// Arrange
+	c := newCachingComponent(t, "abc")
+	c, _ = checkSources(t, c)
+
+	// Act
+	c, cmd := c.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+
+	// Assert
+	if cmd == nil || !c.IsTesting() {
+		t.Error("Expected [r] to run the tests despite the saved run")
+	}
+}
+
+func TestComponent_ChangedSources_RunStraightAway(t *testing.T) {
+	// Arrange
+	c := newCachingComponent(t, "def")
+
+	// Act
+	c, cmd := checkSources(t, c)
+
+	// Assert
+	if cmd == nil || !c.IsTesting() {
+		t.Error("Expected the tests to run without an offer")
+	}
+	if strings.Contains(c.View(), "No source changes") {
+		t.Errorf("Expected no saved run to be offered, got:\n%s", c.View())
+	}
+}
+
+func TestComponent_StartAutoTest_RequiresDownload(t *testing.T) {
+	// Arrange
+	original := config.ConfigFilePath
+	config.ConfigFilePath = filepath.Join(t.TempDir(), "config.yml")
+	t.Cleanup(func() { config.ConfigFilePath = original })
+	if err := os.WriteFile(config.ConfigFilePath, []byte("{}\n"), 0600); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+	variant := api.Project{ID: "p1", Name: "Journal API", Language: "go"}
+	c := NewForTesting([]api.Project{variant}, stubRunner{}, config.NewConfigManager(nil), nil)
+
+	// Act
+	cmd := c.StartAutoTest(&variant)
+
+	// Assert
+	if cmd != nil || c.IsTesting() {
+		t.Error("Expected no run for a project that isn't downloaded")
+	}
+	if !strings.Contains(c.View(), "Project must be downloaded before testing") {
+		t.Errorf("Expected the download notice, got:\n%s", c.View())
+	}
+}
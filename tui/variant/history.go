@@ -0,0 +1,40 @@
+package variant
+
+import (
+	"404skill-cli/api"
+	"404skill-cli/tui/history"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openHistory shows the recent test runs of a variant
+func (c *Component) openHistory(variant *api.Project) {
+	if c.configManager == nil {
+		return
+	}
+	c.history = history.New(variant.Name, c.configManager.GetRunHistory(variant.ID), c.configManager.IsHistoryRelativeTime())
+}
+
+// updateHistory passes messages to the open history until it is closed
+func (c *Component) updateHistory(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case history.CloseMsg:
+		c.history = nil
+		return nil
+	case history.TimeFormatToggledMsg:
+		if err := c.configManager.SetHistoryRelativeTime(msg.Relative); err != nil && c.tracer != nil {
+			_ = c.tracer.TrackError(fmt.Errorf("failed to save history time format: %w", err), "variant", "history")
+		}
+		return nil
+	}
+
+	var cmd tea.Cmd
+	c.history, cmd = c.history.Update(msg)
+	return cmd
+}
+
+// IsViewingHistory returns whether the run history is open
+func (c *Component) IsViewingHistory() bool {
+	return c.history != nil
+}
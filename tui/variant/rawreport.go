@@ -0,0 +1,45 @@
+package variant
+
+import (
+	"404skill-cli/tui/logviewer"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Fallback log viewer size until the terminal size is known
+const (
+	defaultViewerWidth  = 100
+	defaultViewerHeight = 24
+)
+
+// openRawReport shows the report the last run could not parse in the inline viewer
+func (c *Component) openRawReport() {
+	width, height := c.width, c.height
+	if width == 0 || height == 0 {
+		width, height = defaultViewerWidth, defaultViewerHeight
+	}
+
+	viewer := logviewer.New(width, height)
+	if err := viewer.Load(c.rawReport); err != nil {
+		c.errorMsg = err.Error()
+		return
+	}
+	c.logViewer = viewer
+}
+
+// updateLogViewer passes messages to the open viewer until it is closed
+func (c *Component) updateLogViewer(msg tea.Msg) tea.Cmd {
+	if _, ok := msg.(logviewer.CloseMsg); ok {
+		c.logViewer = nil
+		return nil
+	}
+
+	var cmd tea.Cmd
+	c.logViewer, cmd = c.logViewer.Update(msg)
+	return cmd
+}
+
+// IsViewingLog returns whether the inline log viewer is open
+func (c *Component) IsViewingLog() bool {
+	return c.logViewer != nil
+}